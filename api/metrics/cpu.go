@@ -6,7 +6,7 @@
 //	defer provider.Shutdown()
 //
 //	// Get current CPU stats
-//	stats, err := provider.GetStats()
+//	stats, err := provider.GetStats(ctx)
 //	if err != nil {
 //	    log.Fatal(err)
 //	}
@@ -122,12 +122,110 @@ type CPUMetrics interface {
 	//   - ErrShutdown if the provider has been shut down
 	GetEfficiencyCoreCount() (int, error)
 
-	// GetStats returns current CPU statistics.
+	// GetStats returns current CPU statistics. The context can be used to
+	// cancel a sample in progress, matching GetContainerStats,
+	// GetPerCoreStats, and the rest of this interface.
 	// Returns:
 	//   - CPU statistics including usage, frequency, and core information
 	//   - ErrHardwareAccess if the statistics cannot be collected
 	//   - ErrShutdown if the provider has been shut down
-	GetStats() (*types.CPUStats, error)
+	GetStats(ctx context.Context) (*types.CPUStats, error)
+
+	// GetContainerStats returns current CPU statistics, mirroring GetStats,
+	// but skips collecting a fresh sample and returns previous unchanged if
+	// less than the provider's configured sample window has elapsed since
+	// previous was taken. This lets callers that keep their own CPUStats
+	// history across calls — long-polling monitors chief among them — pass
+	// in the prior snapshot explicitly instead of relying on the provider
+	// to retain state. If previous is nil, it always collects a fresh
+	// sample, equivalent to GetStats.
+	// Returns:
+	//   - CPU statistics, either freshly collected or previous unchanged
+	//   - ErrHardwareAccess if the statistics cannot be collected
+	//   - ErrShutdown if the provider has been shut down
+	GetContainerStats(ctx context.Context, previous *types.CPUStats) (*types.CPUStats, error)
+
+	// GetPerCoreStats returns per-core usage statistics, computed from the
+	// delta between this call and the previous one. The first call after
+	// the provider is created returns zeroed percentages for every core,
+	// since no prior sample exists yet.
+	// Returns:
+	//   - Per-core statistics, one entry per logical core
+	//   - ErrHardwareAccess if the statistics cannot be collected
+	//   - ErrShutdown if the provider has been shut down
+	GetPerCoreStats(ctx context.Context) ([]types.CoreStats, error)
+
+	// WatchPerCore starts monitoring per-core CPU statistics and sends
+	// updates to the provided channel, mirroring Watch but for per-core
+	// data. The context can be used to stop monitoring.
+	// Returns:
+	//   - Channel receiving per-core statistics updates
+	//   - ErrInvalidInterval if interval is not positive
+	//   - ErrShutdown if the provider has been shut down
+	WatchPerCore(ctx context.Context, interval time.Duration) (<-chan []types.CoreStats, error)
+
+	// GetInterrupts returns interrupt, context-switch, syscall, and trap
+	// rates, computed from the delta between this call and the previous
+	// one, for callers who only want these saturation figures rather than a
+	// full GetStats call. As with the same fields on CPUStats, these
+	// currently always read 0 on Darwin, which has no stable public
+	// counter for them.
+	// Returns:
+	//   - Interrupt/context-switch/syscall/trap rates
+	//   - ErrHardwareAccess if the underlying collection fails
+	//   - ErrShutdown if the provider has been shut down
+	GetInterrupts(ctx context.Context) (*types.InterruptStats, error)
+
+	// GetStatsWithOptions returns CPU statistics like GetStats, but trims
+	// the result to the fields opts selects, skipping per-core and
+	// per-mode scanning for anything the caller didn't ask for. This
+	// mirrors the percpu/totalcpu toggles of the telegraf system plugin,
+	// letting callers that only need, say, load averages avoid the cost
+	// of per-core enumeration and per-mode tick breakdowns.
+	// Returns:
+	//   - CPU statistics trimmed to opts' selection
+	//   - ErrHardwareAccess if the statistics cannot be collected
+	//   - ErrShutdown if the provider has been shut down
+	GetStatsWithOptions(ctx context.Context, opts types.CollectOptions) (*types.CPUStats, error)
+
+	// GetStatsFiltered returns CPU statistics like GetStats, but restricts
+	// CoreUsage, TotalUsage, PerformanceCores/EfficiencyCores, and
+	// FrequencyMHz to the logical cores selected by sel. This is useful on
+	// Apple Silicon for watching only P-cores or only E-cores, or on any
+	// platform for trimming per-core output down to a handful of indices.
+	// A zero-value CoreSelector selects every core, equivalent to GetStats.
+	// Returns:
+	//   - CPU statistics restricted to the selected cores
+	//   - ErrHardwareAccess if the statistics cannot be collected
+	//   - ErrShutdown if the provider has been shut down
+	GetStatsFiltered(ctx context.Context, sel types.CoreSelector) (*types.CPUStats, error)
+
+	// WatchFiltered starts monitoring CPU metrics like Watch, but applies
+	// sel to every sample the same way GetStatsFiltered does.
+	// Returns:
+	//   - Channel receiving filtered CPU statistics updates
+	//   - ErrInvalidInterval if interval is not positive
+	//   - ErrShutdown if the provider has been shut down
+	WatchFiltered(ctx context.Context, interval time.Duration, sel types.CoreSelector) (<-chan *types.CPUStats, error)
+
+	// WatchWithSelector starts monitoring CPU metrics like WatchFiltered,
+	// but takes its interval and CoreSelector bundled into a single
+	// types.WatchOptions, and additionally populates each sample's
+	// CoreUsageByIndex with the selected cores' usage keyed by their
+	// original logical index (CoreUsage itself stays compacted to
+	// selection order, as WatchFiltered leaves it). Collecting a subset of
+	// cores still requires a full host_processor_info sample underneath,
+	// the same way GetStatsFiltered does; WatchWithSelector trims the
+	// result, not the syscall, so it mainly benefits callers who would
+	// otherwise re-derive original indices from a compacted CoreUsage
+	// slice themselves. Named distinctly from Provider.WatchWithOptions on
+	// the Darwin provider, which already uses that name for EWMA smoothing
+	// and adaptive-interval sampling.
+	// Returns:
+	//   - Channel receiving filtered CPU statistics updates
+	//   - ErrInvalidInterval if opts.Interval is not positive
+	//   - ErrShutdown if the provider has been shut down
+	WatchWithSelector(ctx context.Context, opts types.WatchOptions) (<-chan *types.CPUStats, error)
 
 	// GetPlatform returns information about the CPU platform.
 	// Returns:
@@ -136,6 +234,56 @@ type CPUMetrics interface {
 	//   - ErrShutdown if the provider has been shut down
 	GetPlatform() (*types.CPUPlatform, error)
 
+	// GetUsageNanoCores returns the current decayed-moving-average CPU
+	// usage rate, in units of 1e-9 of a core, mirroring the Kubernetes CRI
+	// stats API's UsageNanoCores field. This is CPUStats.UsageNanoCores
+	// for callers who only want this one smoothed figure.
+	// Returns:
+	//   - Current usage rate in nanocores
+	//   - ErrHardwareAccess if the statistics cannot be collected
+	//   - ErrShutdown if the provider has been shut down
+	GetUsageNanoCores(ctx context.Context) (uint64, error)
+
+	// GetCoreFrequencies returns the current instantaneous frequency of
+	// each logical core in MHz, indexed by core index. This is only
+	// applicable to Apple Silicon Macs, where per-core frequency differs
+	// from the single PerfFrequencyMHz/EffiFrequencyMHz bucket GetStats
+	// reports.
+	// Returns:
+	//   - Current per-core frequencies in MHz
+	//   - ErrUnsupportedPlatform on Intel Macs or other platforms
+	//   - ErrHardwareAccess if the frequencies cannot be determined
+	//   - ErrShutdown if the provider has been shut down
+	GetCoreFrequencies() ([]uint64, error)
+
+	// GetCoreResidencies returns per-core frequency and C-state residency
+	// detail, one entry per logical core. This is only applicable to
+	// Apple Silicon Macs.
+	// Returns:
+	//   - Per-core residency statistics
+	//   - ErrUnsupportedPlatform on Intel Macs or other platforms
+	//   - ErrHardwareAccess if the residencies cannot be determined
+	//   - ErrShutdown if the provider has been shut down
+	GetCoreResidencies() ([]types.CoreResidency, error)
+
+	// GetUptime returns the duration the system has been running since
+	// boot. This is CPUStats.Uptime for callers who only want this one
+	// figure without collecting a full sample.
+	// Returns:
+	//   - System uptime
+	//   - ErrHardwareAccess if boot time cannot be determined
+	//   - ErrShutdown if the provider has been shut down
+	GetUptime(ctx context.Context) (time.Duration, error)
+
+	// GetBootTime returns the time the system was last booted. This is
+	// CPUStats.BootTime for callers who only want this one figure without
+	// collecting a full sample.
+	// Returns:
+	//   - System boot time
+	//   - ErrHardwareAccess if boot time cannot be determined
+	//   - ErrShutdown if the provider has been shut down
+	GetBootTime(ctx context.Context) (time.Time, error)
+
 	// Watch starts monitoring CPU metrics and sends updates to the provided channel.
 	// The context can be used to stop monitoring. When the context is cancelled,
 	// the channel will be closed after any pending updates are sent.
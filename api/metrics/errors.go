@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// HardwareAccessError wraps ErrHardwareAccess with the specific operation
+// that failed, so callers that need more than "something went wrong with
+// hardware access" can branch on Op instead of parsing the error string.
+// errors.Is(err, ErrHardwareAccess) still works via Unwrap.
+type HardwareAccessError struct {
+	Op string
+}
+
+func (e *HardwareAccessError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrHardwareAccess, e.Op)
+}
+
+func (e *HardwareAccessError) Unwrap() error { return ErrHardwareAccess }
+
+// TransientError indicates a failure the caller can expect to resolve on
+// its own after RetryAfter elapses, with no other change in circumstance
+// needed — Darwin's host_processor_info requiring two samples spaced apart
+// before it can report a usage delta is the motivating case.
+// errors.As(err, new(*TransientError)) (or IsTransient) lets a caller, or a
+// context-aware retry helper, distinguish this from a permanent
+// HardwareAccessError instead of treating every failure the same way.
+type TransientError struct {
+	Op         string
+	RetryAfter time.Duration
+}
+
+func (e *TransientError) Error() string {
+	return fmt.Sprintf("%s: not ready yet, retry after %s", e.Op, e.RetryAfter)
+}
+
+// PermissionError indicates the calling process lacks the privilege needed
+// to read a metric, analogous to Linux's kernel.perf_event_paranoid gating
+// access to performance counters. On Darwin this covers frequency/power
+// reads that silently return zero rather than erroring when run without
+// root. errors.Is(err, ErrHardwareAccess) still works via Unwrap.
+type PermissionError struct {
+	Op string
+}
+
+func (e *PermissionError) Error() string {
+	return fmt.Sprintf("%s: permission denied, try running with elevated privileges", e.Op)
+}
+
+func (e *PermissionError) Unwrap() error { return ErrHardwareAccess }
+
+// IsTransient reports whether err is a *TransientError, letting callers
+// decide whether to retry without needing to construct one themselves.
+func IsTransient(err error) bool {
+	var te *TransientError
+	return errors.As(err, &te)
+}
@@ -0,0 +1,34 @@
+package metrics_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+)
+
+func TestHardwareAccessErrorUnwrapsToSentinel(t *testing.T) {
+	err := &metrics.HardwareAccessError{Op: "failed to get host processor information"}
+	if !errors.Is(err, metrics.ErrHardwareAccess) {
+		t.Errorf("errors.Is(err, ErrHardwareAccess) = false, want true")
+	}
+}
+
+func TestPermissionErrorUnwrapsToSentinel(t *testing.T) {
+	err := &metrics.PermissionError{Op: "detect CPU frequency"}
+	if !errors.Is(err, metrics.ErrHardwareAccess) {
+		t.Errorf("errors.Is(err, ErrHardwareAccess) = false, want true")
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	te := &metrics.TransientError{Op: "get_cpu_stats", RetryAfter: 500 * time.Millisecond}
+	if !metrics.IsTransient(te) {
+		t.Errorf("IsTransient(%v) = false, want true", te)
+	}
+
+	if metrics.IsTransient(metrics.ErrHardwareAccess) {
+		t.Errorf("IsTransient(ErrHardwareAccess) = true, want false")
+	}
+}
@@ -24,6 +24,16 @@ type PowerMetrics interface {
 	// GetBatteryPresent returns whether a battery is present in the system.
 	GetBatteryPresent(ctx context.Context) (bool, error)
 
+	// GetBatteryHealth returns the current battery health classification.
+	// Returns types.ErrNoBattery if no battery is present.
+	GetBatteryHealth(ctx context.Context) (types.BatteryHealth, error)
+
+	// GetBatteryHealthPercentage returns the raw MaxCapacity/DesignCapacity
+	// ratio as a percentage (0-100), independent of the CycleCount-based
+	// classification returned by GetBatteryHealth.
+	// Returns types.ErrNoBattery if no battery is present.
+	GetBatteryHealthPercentage(ctx context.Context) (float64, error)
+
 	// Watch starts monitoring power metrics and sends updates to the provided channel.
 	// The channel will be closed when monitoring stops or an error occurs.
 	// The interval parameter specifies how often to collect metrics.
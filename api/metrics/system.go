@@ -0,0 +1,32 @@
+// Package metrics provides interfaces for collecting system metrics.
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// SystemMetrics provides an interface for collecting system-level metrics
+// such as uptime and boot time.
+type SystemMetrics interface {
+	// BootTime returns the time the system was last booted.
+	BootTime(ctx context.Context) (time.Time, error)
+
+	// Uptime returns the duration the system has been running since boot.
+	Uptime(ctx context.Context) (time.Duration, error)
+
+	// GetStats returns current system statistics.
+	GetStats(ctx context.Context) (*types.SystemStats, error)
+
+	// Watch starts monitoring system metrics and sends updates to the provided channel.
+	// The channel will be closed when monitoring stops or an error occurs.
+	// The interval parameter specifies how often to collect metrics.
+	// Returns types.ErrInvalidInterval if interval is not positive.
+	Watch(ctx context.Context, interval time.Duration) (<-chan *types.SystemStats, error)
+
+	// Shutdown cleans up any resources used by the provider.
+	// This should be called when the provider is no longer needed.
+	Shutdown() error
+}
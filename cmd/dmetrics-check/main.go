@@ -0,0 +1,162 @@
+// Command dmetrics-check runs a single-shot evaluation of CPU and battery
+// metrics against configurable warning/critical thresholds and prints
+// Nagios plugin output, for use as an Icinga2/check_mk/Nagios plugin.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/internal/cpu"
+	"github.com/sm-moshi/dmetrics-go/internal/power"
+	"github.com/sm-moshi/dmetrics-go/pkg/check"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/system"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+// thresholds holds the raw flag values for every threshold this plugin
+// understands, so evaluate doesn't need a long positional parameter list.
+type thresholds struct {
+	cpuUsageWarn       string
+	cpuUsageCrit       string
+	batteryPercentWarn string
+	batteryPercentCrit string
+	batteryCyclesWarn  string
+	batteryCyclesCrit  string
+	uptimeWarn         string
+	uptimeCrit         string
+}
+
+func run() int {
+	t := thresholds{}
+	flag.StringVar(&t.cpuUsageWarn, "cpu-usage-warn", "", "warning range for total CPU usage percentage")
+	flag.StringVar(&t.cpuUsageCrit, "cpu-usage-crit", "", "critical range for total CPU usage percentage")
+	flag.StringVar(&t.batteryPercentWarn, "battery-percent-warn", "", "warning range for battery charge percentage")
+	flag.StringVar(&t.batteryPercentCrit, "battery-percent-crit", "", "critical range for battery charge percentage")
+	flag.StringVar(&t.batteryCyclesWarn, "battery-cycles-warn", "", "warning range for battery charge cycle count")
+	flag.StringVar(&t.batteryCyclesCrit, "battery-cycles-crit", "", "critical range for battery charge cycle count")
+	flag.StringVar(&t.uptimeWarn, "uptime-warn", "", "warning range for system uptime in seconds (e.g. 300: to alert on a recent reboot)")
+	flag.StringVar(&t.uptimeCrit, "uptime-crit", "", "critical range for system uptime in seconds")
+	timeout := flag.Duration("timeout", 10*time.Second, "maximum time to spend collecting metrics")
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	result, err := evaluate(ctx, t)
+	if err != nil {
+		fmt.Printf("UNKNOWN - %v\n", err)
+		return check.StatusUnknown.ExitCode()
+	}
+
+	fmt.Println(result.String())
+	return result.Status.ExitCode()
+}
+
+func evaluate(ctx context.Context, t thresholds) (check.Result, error) {
+	cpuProvider := cpu.NewProvider()
+	defer cpuProvider.Shutdown()
+
+	powerProvider := power.NewProvider()
+	defer powerProvider.Shutdown()
+
+	systemProvider := system.NewProvider()
+	defer systemProvider.Shutdown()
+
+	cpuResult, err := evaluateCPU(ctx, cpuProvider, t)
+	if err != nil {
+		return check.Result{}, err
+	}
+	results := []check.Result{cpuResult}
+
+	uptimeResult, err := evaluateUptime(ctx, systemProvider, t)
+	if err != nil {
+		return check.Result{}, err
+	}
+	results = append(results, uptimeResult)
+
+	present, err := powerProvider.GetBatteryPresent(ctx)
+	if err != nil {
+		return check.Result{}, fmt.Errorf("check battery presence: %w", err)
+	}
+	if present {
+		batteryResult, err := evaluateBattery(ctx, powerProvider, t)
+		if err != nil {
+			return check.Result{}, err
+		}
+		results = append(results, batteryResult)
+	}
+
+	return check.Merge(results...), nil
+}
+
+func evaluateCPU(ctx context.Context, provider metrics.CPUMetrics, t thresholds) (check.Result, error) {
+	warn, err := check.ParseThreshold(t.cpuUsageWarn)
+	if err != nil {
+		return check.Result{}, err
+	}
+	crit, err := check.ParseThreshold(t.cpuUsageCrit)
+	if err != nil {
+		return check.Result{}, err
+	}
+
+	stats, err := provider.GetStats(ctx)
+	if err != nil {
+		return check.Result{}, fmt.Errorf("collect cpu stats: %w", err)
+	}
+
+	return check.Evaluate("cpu_usage", stats.TotalUsage, "%", warn, crit), nil
+}
+
+func evaluateUptime(ctx context.Context, provider metrics.SystemMetrics, t thresholds) (check.Result, error) {
+	warn, err := check.ParseThreshold(t.uptimeWarn)
+	if err != nil {
+		return check.Result{}, err
+	}
+	crit, err := check.ParseThreshold(t.uptimeCrit)
+	if err != nil {
+		return check.Result{}, err
+	}
+
+	stats, err := provider.GetStats(ctx)
+	if err != nil {
+		return check.Result{}, fmt.Errorf("collect system stats: %w", err)
+	}
+
+	return check.Evaluate("uptime", stats.UptimeSeconds, "s", warn, crit), nil
+}
+
+func evaluateBattery(ctx context.Context, provider metrics.PowerMetrics, t thresholds) (check.Result, error) {
+	percentWarn, err := check.ParseThreshold(t.batteryPercentWarn)
+	if err != nil {
+		return check.Result{}, err
+	}
+	percentCrit, err := check.ParseThreshold(t.batteryPercentCrit)
+	if err != nil {
+		return check.Result{}, err
+	}
+	cyclesWarn, err := check.ParseThreshold(t.batteryCyclesWarn)
+	if err != nil {
+		return check.Result{}, err
+	}
+	cyclesCrit, err := check.ParseThreshold(t.batteryCyclesCrit)
+	if err != nil {
+		return check.Result{}, err
+	}
+
+	stats, err := provider.GetStats(ctx)
+	if err != nil {
+		return check.Result{}, fmt.Errorf("collect power stats: %w", err)
+	}
+
+	percentResult := check.Evaluate("battery", stats.Percentage, "%", percentWarn, percentCrit)
+	cyclesResult := check.Evaluate("cycles", float64(stats.CycleCount), "", cyclesWarn, cyclesCrit)
+	return check.Merge(percentResult, cyclesResult), nil
+}
@@ -0,0 +1,44 @@
+// Command dmetrics-exporter runs dmetrics as a node-exporter-style agent,
+// serving CPU and power metrics in Prometheus/OpenMetrics text format over
+// HTTP.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/internal/cpu"
+	"github.com/sm-moshi/dmetrics-go/internal/power"
+	"github.com/sm-moshi/dmetrics-go/pkg/exporter"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/system"
+)
+
+func main() {
+	listen := flag.String("listen", ":9100", "address to listen on for scrape requests")
+	path := flag.String("path", "/metrics", "HTTP path to serve metrics on")
+	timeout := flag.Duration("scrape-timeout", 10*time.Second, "maximum time to spend collecting a single scrape")
+	flag.Parse()
+
+	cpuProvider := cpu.NewProvider()
+	defer cpuProvider.Shutdown()
+
+	powerProvider := power.NewProvider()
+	defer powerProvider.Shutdown()
+
+	systemProvider := system.NewProvider()
+	defer systemProvider.Shutdown()
+
+	exp := exporter.New(
+		exporter.NewCPUCollector(cpuProvider),
+		exporter.NewPowerCollector(powerProvider),
+		exporter.NewSystemCollector(systemProvider),
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle(*path, http.TimeoutHandler(exp, *timeout, "scrape timed out"))
+
+	log.Printf("dmetrics-exporter listening on %s, serving %s", *listen, *path)
+	log.Fatal(http.ListenAndServe(*listen, mux))
+}
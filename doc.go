@@ -25,6 +25,7 @@ Example usage:
 	package main
 
 	import (
+	    "context"
 	    "fmt"
 	    "log"
 	    "time"
@@ -36,7 +37,7 @@ Example usage:
 	    defer provider.Shutdown()
 
 	    // Get current CPU stats
-	    stats, err := provider.GetStats()
+	    stats, err := provider.GetStats(context.Background())
 	    if err != nil {
 	        log.Fatal(err)
 	    }
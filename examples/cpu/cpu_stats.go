@@ -13,12 +13,40 @@ import (
 	"time"
 
 	"github.com/sm-moshi/dmetrics-go/internal/cpu"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/system"
 	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
 )
 
-const cpuUsageBarScale = 5 // Each bar character represents 5% CPU usage
+const (
+	cpuUsageBarScale = 5  // Each bar character represents 5% CPU usage
+	usageBarWidth    = 20 // Total width of a usage bar, in characters
 
-func printStats(stats *types.CPUStats) error {
+	// perStateEnabled mirrors the PerState field of the CollectOptions
+	// passed to cpu.NewProvider in run, below, so printStats knows whether
+	// to expect a populated User/System/Idle/Nice breakdown.
+	perStateEnabled = false
+)
+
+// usageBar renders value as a fixed-width bar graph scaled against max,
+// e.g. for a raw (unnormalised) usage value whose max is 100*PhysicalCores
+// rather than the usual 100.
+func usageBar(value, max float64) string {
+	if max <= 0 {
+		max = 100
+	}
+	barLength := int(value / max * usageBarWidth)
+	if value > 0 && barLength == 0 {
+		barLength = 1 // Show at least one bar for non-zero usage
+	}
+	if barLength > usageBarWidth {
+		barLength = usageBarWidth
+	}
+	bar := strings.Repeat("█", barLength)
+	padding := strings.Repeat(" ", usageBarWidth-barLength)
+	return fmt.Sprintf("[%s%s]", bar, padding)
+}
+
+func printStats(stats *types.CPUStats, sys *types.SystemStats) error {
 	// Clear screen (ANSI escape sequence)
 	fmt.Print("\033[H\033[2J")
 
@@ -26,6 +54,11 @@ func printStats(stats *types.CPUStats) error {
 	fmt.Printf("CPU Statistics (Updated: %s)\n", stats.Timestamp.Format("15:04:05"))
 	fmt.Printf("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
 
+	if sys != nil {
+		fmt.Printf("Host: %s (kernel %s), up %s since %s\n\n",
+			sys.Hostname, sys.KernelVersion, sys.UptimeString, sys.BootTime.Format("2006-01-02 15:04:05"))
+	}
+
 	// Print core information
 	fmt.Printf("Core Configuration:\n")
 	fmt.Printf("  Physical Cores: %d\n", stats.PhysicalCores)
@@ -48,9 +81,18 @@ func printStats(stats *types.CPUStats) error {
 
 	// Print usage statistics
 	fmt.Printf("\nUsage Statistics:\n")
-	fmt.Printf("  Total Usage: %.2f%%\n", stats.TotalUsage)
-	fmt.Printf("  User: %.2f%%, System: %.2f%%, Idle: %.2f%%, Nice: %.2f%%\n",
-		stats.User, stats.System, stats.Idle, stats.Nice)
+	fmt.Printf("  Total Usage (normalised):   %s %.2f%%\n",
+		usageBar(stats.TotalUsage, 100), stats.TotalUsage)
+	fmt.Printf("  Total Usage (raw, %d cores): %s %.2f%%\n",
+		stats.PhysicalCores, usageBar(stats.TotalUsageUnnormalised, 100*float64(stats.PhysicalCores)), stats.TotalUsageUnnormalised)
+	if perStateEnabled {
+		fmt.Printf("  User: %.2f%%, System: %.2f%%, Idle: %.2f%%, Nice: %.2f%% (normalised)\n",
+			stats.User, stats.System, stats.Idle, stats.Nice)
+		fmt.Printf("  User: %.2f%%, System: %.2f%%, Idle: %.2f%%, Nice: %.2f%% (raw)\n",
+			stats.UserPct, stats.SystemPct, stats.IdlePct, stats.NicePct)
+	} else {
+		fmt.Printf("  (per-state breakdown disabled; see CollectOptions.PerState)\n")
+	}
 	fmt.Printf("  Load Averages (1, 5, 15 min): %.2f, %.2f, %.2f\n",
 		stats.LoadAvg[0], stats.LoadAvg[1], stats.LoadAvg[2])
 
@@ -99,15 +141,30 @@ func run() error {
 		cancel()
 	}()
 
-	provider := cpu.NewProvider()
+	// Collect per-core usage and a total, but skip the per-mode
+	// (user/system/idle/nice) breakdown: this example's core bar graphs
+	// don't need it, so there's no reason to pay for it.
+	provider := cpu.NewProvider(cpu.WithCollectOptions(types.CollectOptions{
+		PerCPU:     true,
+		TotalCPU:   true,
+		PerState:   false,
+		Normalised: true,
+	}))
 	defer provider.Shutdown()
 
+	sysProvider := system.NewProvider()
+	defer sysProvider.Shutdown()
+
 	// Initial check to ensure we can get stats
-	stats, err := provider.GetStats()
+	stats, err := provider.GetStats(ctx)
 	if err != nil {
 		return fmt.Errorf("initial stats check failed: %w", err)
 	}
-	if err := printStats(stats); err != nil {
+	sysStats, err := sysProvider.GetStats(ctx)
+	if err != nil {
+		return fmt.Errorf("initial system stats check failed: %w", err)
+	}
+	if err := printStats(stats, sysStats); err != nil {
 		return fmt.Errorf("failed to print initial stats: %w", err)
 	}
 
@@ -130,7 +187,7 @@ func run() error {
 				}
 				return fmt.Errorf("CPU monitoring stopped unexpectedly")
 			}
-			if err := printStats(stats); err != nil {
+			if err := printStats(stats, sysStats); err != nil {
 				if ctx.Err() != nil {
 					return nil // Context cancelled, exit silently
 				}
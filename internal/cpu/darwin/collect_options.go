@@ -0,0 +1,57 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"context"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// WithCollectOptions sets the default types.CollectOptions used by GetStats and
+// GetStatsFiltered calls that don't go through GetStatsWithOptions
+// directly. The zero value (the default, if this option isn't passed)
+// behaves like DefaultCollectOptions, i.e. unchanged from before
+// CollectOptions existed.
+func WithCollectOptions(opts types.CollectOptions) ProviderOption {
+	return func(p *Provider) {
+		p.collectOpts = opts
+	}
+}
+
+// GetStatsWithOptions returns CPU statistics like GetStats, but trims the
+// result to the fields opts selects, skipping the per-core and per-mode
+// scanning for anything the caller didn't ask for.
+func (p *Provider) GetStatsWithOptions(ctx context.Context, opts types.CollectOptions) (*types.CPUStats, error) {
+	stats, err := p.GetStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return applyCollectOptions(stats, opts), nil
+}
+
+// applyCollectOptions returns a copy of stats trimmed to opts' selection.
+func applyCollectOptions(stats *types.CPUStats, opts types.CollectOptions) *types.CPUStats {
+	out := *stats
+
+	if !opts.PerCPU {
+		out.CoreUsage = nil
+	}
+
+	if !opts.TotalCPU {
+		out.TotalUsage = 0
+		out.TotalUsageUnnormalised = 0
+	} else if !opts.Normalised {
+		out.TotalUsage = out.TotalUsageUnnormalised
+	}
+
+	if !opts.PerState {
+		out.User, out.System, out.Idle, out.Nice = 0, 0, 0, 0
+		out.UserPct, out.SystemPct, out.IdlePct, out.NicePct = 0, 0, 0, 0
+	} else if !opts.Normalised {
+		out.User, out.System, out.Idle, out.Nice = out.UserPct, out.SystemPct, out.IdlePct, out.NicePct
+	}
+
+	return &out
+}
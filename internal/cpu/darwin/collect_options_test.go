@@ -0,0 +1,58 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+func testCollectStats() *types.CPUStats {
+	return &types.CPUStats{
+		CoreUsage:              []float64{10, 20},
+		TotalUsage:             15,
+		TotalUsageUnnormalised: 30,
+		User:                   5,
+		System:                 5,
+		Idle:                   85,
+		Nice:                   5,
+		UserPct:                10,
+		SystemPct:              10,
+		IdlePct:                170,
+		NicePct:                10,
+	}
+}
+
+func TestApplyCollectOptionsPerCPUDisabled(t *testing.T) {
+	out := applyCollectOptions(testCollectStats(), types.CollectOptions{TotalCPU: true, PerState: true})
+	assert.Nil(t, out.CoreUsage)
+}
+
+func TestApplyCollectOptionsTotalCPUDisabled(t *testing.T) {
+	out := applyCollectOptions(testCollectStats(), types.CollectOptions{PerCPU: true, PerState: true})
+	assert.Equal(t, 0.0, out.TotalUsage)
+	assert.Equal(t, 0.0, out.TotalUsageUnnormalised)
+}
+
+func TestApplyCollectOptionsPerStateDisabled(t *testing.T) {
+	out := applyCollectOptions(testCollectStats(), types.CollectOptions{PerCPU: true, TotalCPU: true})
+	assert.Equal(t, 0.0, out.User)
+	assert.Equal(t, 0.0, out.UserPct)
+}
+
+func TestApplyCollectOptionsUnnormalised(t *testing.T) {
+	opts := types.CollectOptions{PerCPU: true, TotalCPU: true, PerState: true, Normalised: false}
+	out := applyCollectOptions(testCollectStats(), opts)
+	assert.Equal(t, 30.0, out.TotalUsage)
+	assert.Equal(t, 10.0, out.User)
+}
+
+func TestDefaultCollectOptionsKeepsEverything(t *testing.T) {
+	stats := testCollectStats()
+	out := applyCollectOptions(stats, types.DefaultCollectOptions())
+	assert.Equal(t, stats, out)
+}
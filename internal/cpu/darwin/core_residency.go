@@ -0,0 +1,188 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// This file collects per-core frequency and C-state residency metrics on
+// Apple Silicon, the finer-grained counterpart to uncore.go's per-cluster
+// frequencies. A true per-core reading requires subscribing to the
+// IOReport framework's "CPU Stats"/"Energy Model" channels, the same
+// private API powermetrics itself uses internally; IOReport has no public
+// header in the macOS SDK, so (mirroring sampleClusterFrequencies below)
+// this shells out to `powermetrics --samplers cpu_power` and parses its
+// per-core lines instead of linking against the private framework
+// directly.
+const residencyRefreshInterval = 5 * time.Second
+
+var (
+	// coreFreqRe matches e.g. "CPU 0 frequency: 3200 MHz".
+	coreFreqRe = regexp.MustCompile(`^CPU (\d+) frequency:\s*(\d+)\s*MHz`)
+
+	// coreActiveResidencyRe matches e.g. "CPU 0 active residency: 42.10%".
+	coreActiveResidencyRe = regexp.MustCompile(`^CPU (\d+) active residency:\s*([\d.]+)%`)
+
+	// coreIdleResidencyRe matches e.g. "CPU 0 idle residency: 57.90%".
+	coreIdleResidencyRe = regexp.MustCompile(`^CPU (\d+) idle residency:\s*([\d.]+)%`)
+
+	// coreDVFMResidencyRe matches e.g. "CPU 0 P1 state residency: 12.34%".
+	coreDVFMResidencyRe = regexp.MustCompile(`^CPU (\d+) (P\d+) state residency:\s*([\d.]+)%`)
+)
+
+// coreResidencyState caches the most recently collected per-core sample,
+// mirroring uncoreState's background-refresh pattern.
+type coreResidencyState struct {
+	mu        sync.Mutex
+	residuals []types.CoreResidency
+	startOnce sync.Once
+}
+
+// snapshot returns the most recently cached per-core residencies, starting
+// the background sampler on first use. Like uncoreState.snapshot, the
+// first call sees an empty slice since no sample has completed yet.
+func (s *coreResidencyState) snapshot(platform *types.CPUPlatform) []types.CoreResidency {
+	s.startOnce.Do(func() { go s.loop(platform) })
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.residuals
+}
+
+func (s *coreResidencyState) loop(platform *types.CPUPlatform) {
+	for {
+		residuals := sampleCoreResidencies(platform)
+		s.mu.Lock()
+		s.residuals = residuals
+		s.mu.Unlock()
+		time.Sleep(residencyRefreshInterval)
+	}
+}
+
+// sampleCoreResidencies runs powermetrics once and parses its per-core
+// frequency/residency lines. Returns nil if powermetrics isn't available
+// or the caller lacks permission to run it (it requires root), the same
+// graceful degradation sampleClusterFrequencies uses.
+func sampleCoreResidencies(platform *types.CPUPlatform) []types.CoreResidency {
+	out, err := exec.Command("powermetrics", "--samplers", "cpu_power",
+		"-i", strconv.Itoa(int(clusterSampleDuration.Milliseconds())), "-n", "1").Output()
+	if err != nil {
+		return nil
+	}
+	return parsePowermetricsCoreResidencies(string(out), platform)
+}
+
+// parsePowermetricsCoreResidencies extracts per-core frequency, active/idle
+// residency, and DVFM-state residency lines from `powermetrics
+// --samplers cpu_power` output, e.g.:
+//
+//	CPU 0 frequency: 1050 MHz
+//	CPU 0 active residency: 42.10%
+//	CPU 0 idle residency: 57.90%
+//	CPU 0 P0 state residency: 30.00%
+//	CPU 0 P1 state residency: 12.10%
+func parsePowermetricsCoreResidencies(output string, platform *types.CPUPlatform) []types.CoreResidency {
+	byCore := map[int]*types.CoreResidency{}
+
+	coreFor := func(idx int) *types.CoreResidency {
+		c, ok := byCore[idx]
+		if !ok {
+			c = &types.CoreResidency{CoreIndex: idx, CoreType: coreTypeForIndex(idx, platform)}
+			byCore[idx] = c
+		}
+		return c
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		if m := coreFreqRe.FindStringSubmatch(line); m != nil {
+			idx, _ := strconv.Atoi(m[1])
+			freq, _ := strconv.ParseUint(m[2], 10, 64)
+			coreFor(idx).FrequencyMHz = freq
+			continue
+		}
+		if m := coreActiveResidencyRe.FindStringSubmatch(line); m != nil {
+			idx, _ := strconv.Atoi(m[1])
+			pct, _ := strconv.ParseFloat(m[2], 64)
+			coreFor(idx).ActiveResidencyPct = pct
+			continue
+		}
+		if m := coreIdleResidencyRe.FindStringSubmatch(line); m != nil {
+			idx, _ := strconv.Atoi(m[1])
+			pct, _ := strconv.ParseFloat(m[2], 64)
+			coreFor(idx).IdleResidencyPct = pct
+			continue
+		}
+		if m := coreDVFMResidencyRe.FindStringSubmatch(line); m != nil {
+			idx, _ := strconv.Atoi(m[1])
+			pct, _ := strconv.ParseFloat(m[3], 64)
+			core := coreFor(idx)
+			if core.DVFMStateResidencyPct == nil {
+				core.DVFMStateResidencyPct = map[string]float64{}
+			}
+			core.DVFMStateResidencyPct[m[2]] = pct
+		}
+	}
+
+	if len(byCore) == 0 {
+		return nil
+	}
+
+	maxIdx := 0
+	for idx := range byCore {
+		if idx > maxIdx {
+			maxIdx = idx
+		}
+	}
+	out := make([]types.CoreResidency, 0, len(byCore))
+	for i := 0; i <= maxIdx; i++ {
+		if c, ok := byCore[i]; ok {
+			out = append(out, *c)
+		}
+	}
+	return out
+}
+
+// GetCoreFrequencies returns the current instantaneous frequency of each
+// logical core in MHz. Always returns ErrUnsupportedPlatform on Intel
+// Macs, matching GetPerformanceFrequency's graceful degradation.
+func (p *Provider) GetCoreFrequencies() ([]uint64, error) {
+	platform, err := p.GetPlatform()
+	if err != nil {
+		return nil, err
+	}
+	if !platform.IsAppleSilicon {
+		return nil, metrics.ErrUnsupportedPlatform
+	}
+
+	residencies := p.coreResidency.snapshot(platform)
+	freqs := make([]uint64, len(residencies))
+	for i, r := range residencies {
+		freqs[i] = r.FrequencyMHz
+	}
+	return freqs, nil
+}
+
+// GetCoreResidencies returns per-core frequency and C-state residency
+// detail. Always returns ErrUnsupportedPlatform on Intel Macs, matching
+// GetPerformanceFrequency's graceful degradation.
+func (p *Provider) GetCoreResidencies() ([]types.CoreResidency, error) {
+	platform, err := p.GetPlatform()
+	if err != nil {
+		return nil, err
+	}
+	if !platform.IsAppleSilicon {
+		return nil, metrics.ErrUnsupportedPlatform
+	}
+	return p.coreResidency.snapshot(platform), nil
+}
@@ -0,0 +1,46 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+const samplePowermetricsCoreResidencies = `
+CPU 0 frequency: 1050 MHz
+CPU 0 active residency: 42.10%
+CPU 0 idle residency: 57.90%
+CPU 0 P0 state residency: 30.00%
+CPU 0 P1 state residency: 12.10%
+CPU 1 frequency: 3200 MHz
+CPU 1 active residency: 88.00%
+CPU 1 idle residency: 12.00%
+`
+
+func TestParsePowermetricsCoreResidencies(t *testing.T) {
+	platform := &types.CPUPlatform{IsAppleSilicon: true, PerformanceCores: 1, EfficiencyCores: 1}
+	residencies := parsePowermetricsCoreResidencies(samplePowermetricsCoreResidencies, platform)
+	require := assert.New(t)
+	require.Len(residencies, 2)
+
+	require.Equal(0, residencies[0].CoreIndex)
+	require.Equal(types.CoreTypePerformance, residencies[0].CoreType)
+	require.Equal(uint64(1050), residencies[0].FrequencyMHz)
+	require.Equal(42.10, residencies[0].ActiveResidencyPct)
+	require.Equal(57.90, residencies[0].IdleResidencyPct)
+	require.Equal(map[string]float64{"P0": 30.00, "P1": 12.10}, residencies[0].DVFMStateResidencyPct)
+
+	require.Equal(1, residencies[1].CoreIndex)
+	require.Equal(types.CoreTypeEfficiency, residencies[1].CoreType)
+	require.Equal(uint64(3200), residencies[1].FrequencyMHz)
+}
+
+func TestParsePowermetricsCoreResidenciesEmpty(t *testing.T) {
+	residencies := parsePowermetricsCoreResidencies("no matching lines here", &types.CPUPlatform{})
+	assert.Nil(t, residencies)
+}
@@ -0,0 +1,144 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"context"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// This file implements GetStatsFiltered and WatchFiltered, which let
+// callers restrict CoreUsage, TotalUsage, and the reported frequency to a
+// subset of logical cores -- useful on Apple Silicon for watching only
+// P-cores or only E-cores, or on any platform for trimming noisy per-core
+// output down to a handful of indices.
+//
+// host_processor_info is a whole-system syscall with no way to sample a
+// subset of cores, so GetStatsFiltered always collects the full sample via
+// GetStats and then discards the filtered-out indices when recomputing the
+// aggregate fields below; CoreUsage itself is returned compacted to just
+// the selected cores, in ascending index order.
+
+// GetStatsFiltered returns CPU statistics like GetStats, but restricts
+// CoreUsage, TotalUsage, PerformanceCores/EfficiencyCores, and FrequencyMHz
+// to the logical cores selected by sel.
+func (p *Provider) GetStatsFiltered(ctx context.Context, sel types.CoreSelector) (*types.CPUStats, error) {
+	stats, err := p.GetStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	platform, err := p.GetPlatform()
+	if err != nil {
+		return nil, err
+	}
+
+	return applyCoreSelector(stats, platform, sel), nil
+}
+
+// WatchFiltered monitors CPU statistics like Watch, but applies sel to
+// every sample the same way GetStatsFiltered does.
+func (p *Provider) WatchFiltered(ctx context.Context, interval time.Duration, sel types.CoreSelector) (<-chan *types.CPUStats, error) {
+	if err := p.validateWatchParams(interval); err != nil {
+		return nil, err
+	}
+
+	ch := p.createStatsChannel()
+
+	go func() {
+		defer close(ch)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stats, err := p.GetStatsFiltered(ctx, sel)
+				if err != nil {
+					return
+				}
+				p.sendStatsWithDropping(ch, stats)
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// applyCoreSelector returns a copy of stats with CoreUsage compacted to the
+// cores selected by sel, and TotalUsage, PerformanceCores, EfficiencyCores,
+// and FrequencyMHz recomputed to match.
+func applyCoreSelector(stats *types.CPUStats, platform *types.CPUPlatform, sel types.CoreSelector) *types.CPUStats {
+	out := *stats
+
+	filtered := make([]float64, 0, len(stats.CoreUsage))
+	var usageSum float64
+	var perfCores, effiCores int
+	for i, usage := range stats.CoreUsage {
+		if !coreSelected(i, platform, sel) {
+			continue
+		}
+		filtered = append(filtered, usage)
+		usageSum += usage
+		switch coreTypeForIndex(i, platform) {
+		case types.CoreTypePerformance:
+			perfCores++
+		case types.CoreTypeEfficiency:
+			effiCores++
+		case types.CoreTypeUnknown:
+		}
+	}
+
+	out.CoreUsage = filtered
+	out.PhysicalCores = len(filtered)
+	out.PerformanceCores = perfCores
+	out.EfficiencyCores = effiCores
+
+	if len(filtered) > 0 {
+		out.TotalUsage = usageSum / float64(len(filtered))
+	} else {
+		out.TotalUsage = 0
+	}
+
+	switch {
+	case perfCores > 0 && effiCores == 0:
+		out.FrequencyMHz = stats.PerfFrequencyMHz
+	case effiCores > 0 && perfCores == 0:
+		out.FrequencyMHz = stats.EffiFrequencyMHz
+	}
+
+	return &out
+}
+
+// coreSelected reports whether logical core i passes sel's criteria. A
+// zero-value CoreSelector selects every core.
+func coreSelected(i int, platform *types.CPUPlatform, sel types.CoreSelector) bool {
+	if len(sel.Include) > 0 && !containsCoreIndex(sel.Include, i) {
+		return false
+	}
+	if containsCoreIndex(sel.Exclude, i) {
+		return false
+	}
+	if sel.OnlyPerformance && coreTypeForIndex(i, platform) != types.CoreTypePerformance {
+		return false
+	}
+	if sel.OnlyEfficiency && coreTypeForIndex(i, platform) != types.CoreTypeEfficiency {
+		return false
+	}
+	return true
+}
+
+func containsCoreIndex(indices []int, i int) bool {
+	for _, idx := range indices {
+		if idx == i {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+func testPlatform() *types.CPUPlatform {
+	return &types.CPUPlatform{
+		IsAppleSilicon:   true,
+		PerformanceCores: 2,
+		EfficiencyCores:  2,
+	}
+}
+
+func TestApplyCoreSelectorOnlyPerformance(t *testing.T) {
+	stats := &types.CPUStats{
+		CoreUsage:        []float64{80, 60, 10, 20},
+		TotalUsage:       42.5,
+		PerfFrequencyMHz: 3200,
+		EffiFrequencyMHz: 2000,
+		PhysicalCores:    4,
+		PerformanceCores: 2,
+		EfficiencyCores:  2,
+	}
+
+	out := applyCoreSelector(stats, testPlatform(), types.CoreSelector{OnlyPerformance: true})
+	assert.Equal(t, []float64{80, 60}, out.CoreUsage)
+	assert.Equal(t, 70.0, out.TotalUsage)
+	assert.Equal(t, 2, out.PhysicalCores)
+	assert.Equal(t, 2, out.PerformanceCores)
+	assert.Equal(t, 0, out.EfficiencyCores)
+	assert.Equal(t, uint64(3200), out.FrequencyMHz)
+}
+
+func TestApplyCoreSelectorInclude(t *testing.T) {
+	stats := &types.CPUStats{
+		CoreUsage:     []float64{80, 60, 10, 20},
+		PhysicalCores: 4,
+	}
+
+	out := applyCoreSelector(stats, testPlatform(), types.CoreSelector{Include: []int{1, 3}})
+	assert.Equal(t, []float64{60, 20}, out.CoreUsage)
+	assert.Equal(t, 2, out.PhysicalCores)
+}
+
+func TestApplyCoreSelectorExclude(t *testing.T) {
+	stats := &types.CPUStats{
+		CoreUsage:     []float64{80, 60, 10, 20},
+		PhysicalCores: 4,
+	}
+
+	out := applyCoreSelector(stats, testPlatform(), types.CoreSelector{Exclude: []int{0, 2}})
+	assert.Equal(t, []float64{60, 20}, out.CoreUsage)
+}
+
+func TestCoreSelectedZeroValueSelectsAll(t *testing.T) {
+	platform := testPlatform()
+	for i := 0; i < 4; i++ {
+		assert.True(t, coreSelected(i, platform, types.CoreSelector{}))
+	}
+}
@@ -46,26 +46,87 @@ const (
 // Apple Silicon architectures, with additional methods specific to
 // Apple Silicon systems.
 //
-// The provider maintains minimal state and is safe for concurrent use.
+// The provider holds only a small amount of self-monitoring state (see
+// saturation.go) and is otherwise stateless, using system calls directly.
 // All methods are thread-safe and can be called from multiple goroutines.
 // Resource cleanup is handled automatically through the Shutdown method.
 type Provider struct {
-	// Provider is stateless and uses system calls directly
+	saturation    *saturationRecorder
+	perCore       perCoreState
+	unnormalised  unnormalisedState
+	uncore        uncoreState
+	coreResidency coreResidencyState
+	nanoCore      nanoCoreState
+	sampleWindow  time.Duration
+	collectOpts   types.CollectOptions
 }
 
+// var _ asserts that Provider implements metrics.CPUMetrics at compile
+// time, so a signature drift between the two fails the build here instead
+// of surfacing as an opaque "does not implement" error at a call site.
+var _ metrics.CPUMetrics = (*Provider)(nil)
+
 // NewProvider creates a new Darwin CPU metrics provider.
 func NewProvider() *Provider {
-	initCleanup()
-	return &Provider{}
+	return NewProviderWithOptions()
 }
 
-// GetStats returns current CPU statistics.
-func (p *Provider) GetStats(context.Context) (*types.CPUStats, error) {
-	stats, err := getStats()
+// GetStats returns current CPU statistics. Percentages are computed by the
+// underlying host_processor_info tick deltas between this call and the
+// previous one; the first call after NewProvider has no prior sample and
+// blocks for initialSampleDelay to collect one (see getStats).
+//
+// User, System, Idle, Nice, and TotalUsage are overwritten from a per-core
+// tick delta computed across this call and the previous one (see
+// applyUnnormalisedUsage), replacing getStats' own single-sample reading,
+// which cannot distinguish "instantaneous load" from "cumulative ticks
+// since boot" on its own. TotalUsageUnnormalised and the
+// UserPct/SystemPct/NicePct/IdlePct fields come from the same delta,
+// unnormalised (summed rather than averaged across cores). All of the
+// above read zero on the first call after NewProvider, since there is no
+// prior sample to diff against yet.
+//
+// UsageNanoCores is derived from the same unnormalised delta, converted to
+// the Kubernetes CRI nanocore scale and smoothed across calls by an EWMA
+// (see nanoCoreState); it too reads zero on the first call.
+//
+// UncoreFrequencyMHz and ClusterFrequenciesMHz come from a background
+// sampler (see uncoreState) refreshed every few seconds rather than on
+// every call, since the Apple Silicon path shells out to powermetrics;
+// they too read zero/empty until the first background sample completes.
+//
+// Uptime and BootTime come from a fresh sysctl kern.boottime read on every
+// call; if that read fails, both are left zero rather than failing the
+// whole GetStats call.
+func (p *Provider) GetStats(ctx context.Context) (*types.CPUStats, error) {
+	stats, err := getStats(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return stats, nil
+	p.applyUnnormalisedUsage(stats)
+	p.applyUsageNanoCores(stats)
+	stats.ClusterFrequenciesMHz, stats.UncoreFrequencyMHz = p.uncore.snapshot()
+	if boot, err := p.GetBootTime(ctx); err == nil {
+		stats.BootTime = boot
+		stats.Uptime = time.Since(boot)
+	}
+	return applyCollectOptions(stats, p.collectOpts), nil
+}
+
+// GetContainerStats returns current CPU statistics, mirroring GetStats, but
+// skips collecting a fresh sample and returns previous unchanged if less
+// than the configured WithSampleWindow has elapsed since previous was
+// taken. This lets callers that keep their own CPUStats history across
+// calls — long-polling monitors chief among them — pass in the prior
+// snapshot explicitly instead of relying on the Provider to retain state.
+//
+// If previous is nil, or no sample window is configured, GetContainerStats
+// always collects a fresh sample, equivalent to GetStats.
+func (p *Provider) GetContainerStats(ctx context.Context, previous *types.CPUStats) (*types.CPUStats, error) {
+	if previous != nil && p.sampleWindow > 0 && time.Since(previous.Timestamp) < p.sampleWindow {
+		return previous, nil
+	}
+	return p.GetStats(ctx)
 }
 
 // GetUsage returns the current total CPU usage percentage (0-100).
@@ -76,14 +137,14 @@ func (p *Provider) GetUsage(interval time.Duration) (float64, error) {
 	defer timer.Stop()
 
 	// Get initial usage
-	initial, err := usage()
+	initial, err := usage(context.Background())
 	if err != nil {
 		return 0, err
 	}
 
 	// Wait for interval completion
 	<-timer.C
-	final, err := usage()
+	final, err := usage(context.Background())
 	if err != nil {
 		return 0, err
 	}
@@ -93,12 +154,12 @@ func (p *Provider) GetUsage(interval time.Duration) (float64, error) {
 
 // GetFrequency returns the current CPU frequency in MHz.
 func (p *Provider) GetFrequency() (uint64, error) {
-	return getFrequency()
+	return getFrequency(context.Background())
 }
 
 // GetCoreCount returns the number of CPU cores.
 func (p *Provider) GetCoreCount() (int, error) {
-	stats, err := getStats()
+	stats, err := getStats(context.Background())
 	if err != nil {
 		return 0, err
 	}
@@ -108,7 +169,7 @@ func (p *Provider) GetCoreCount() (int, error) {
 // GetEfficiencyCoreCount returns the number of efficiency cores on Apple Silicon.
 // Returns 0 on Intel processors.
 func (p *Provider) GetEfficiencyCoreCount() (int, error) {
-	stats, err := getStats()
+	stats, err := getStats(context.Background())
 	if err != nil {
 		return 0, err
 	}
@@ -118,7 +179,7 @@ func (p *Provider) GetEfficiencyCoreCount() (int, error) {
 // GetPerformanceCoreCount returns the number of performance cores on Apple Silicon.
 // Returns 0 on Intel processors.
 func (p *Provider) GetPerformanceCoreCount() (int, error) {
-	stats, err := getStats()
+	stats, err := getStats(context.Background())
 	if err != nil {
 		return 0, err
 	}
@@ -152,14 +213,18 @@ func (p *Provider) GetPlatform() (*types.CPUPlatform, error) {
 		return nil, cpuError(err)
 	}
 
+	clusters, uncoreMHz := p.uncore.snapshot()
+
 	return &types.CPUPlatform{
-		IsAppleSilicon:   cPlatform.is_apple_silicon != 0,
-		BrandString:      C.GoStringN((*C.char)(unsafe.Pointer(&cPlatform.brand_string[0])), brandStringLength),
-		FrequencyMHz:     uint64(cPlatform.frequency),
-		PerfFrequencyMHz: uint64(cPlatform.perf_freq),
-		EffiFrequencyMHz: uint64(cPlatform.effi_freq),
-		PerformanceCores: int(cPlatform.perf_cores),
-		EfficiencyCores:  int(cPlatform.effi_cores),
+		IsAppleSilicon:        cPlatform.is_apple_silicon != 0,
+		BrandString:           C.GoStringN((*C.char)(unsafe.Pointer(&cPlatform.brand_string[0])), brandStringLength),
+		FrequencyMHz:          uint64(cPlatform.frequency),
+		PerfFrequencyMHz:      uint64(cPlatform.perf_freq),
+		EffiFrequencyMHz:      uint64(cPlatform.effi_freq),
+		PerformanceCores:      int(cPlatform.perf_cores),
+		EfficiencyCores:       int(cPlatform.effi_cores),
+		UncoreFrequencyMHz:    uncoreMHz,
+		ClusterFrequenciesMHz: clusters,
 	}, nil
 }
 
@@ -195,28 +260,13 @@ func (p *Provider) sendStatsWithDropping(ch chan *types.CPUStats, stats *types.C
 	}
 }
 
-// collectAndSendStats collects CPU stats and sends them to the channel.
-func (p *Provider) collectAndSendStats(ctx context.Context, ch chan *types.CPUStats) {
-	stats, err := p.GetStats(ctx)
-	if err != nil {
-		return // Error is already logged in GetStats
-	}
-	p.sendStatsWithDropping(ch, stats)
-}
-
-// runWatchLoop runs the main monitoring loop.
-func (p *Provider) runWatchLoop(ctx context.Context, interval time.Duration, ch chan *types.CPUStats) {
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			p.collectAndSendStats(ctx, ch)
-		}
-	}
+// GetProviderSaturation returns the fraction (0-1) of the recent watch-loop
+// history spent inside stats collection rather than idle, waiting on the
+// next tick. It lets operators detect when a configured Watch interval is
+// too aggressive for the underlying sysctl/mach calls. If no Watch loop has
+// run yet, it returns 0.
+func (p *Provider) GetProviderSaturation() (float64, error) {
+	return p.saturation.value(), nil
 }
 
 // Watch monitors CPU statistics and sends updates through the returned channel.
@@ -231,18 +281,15 @@ func (p *Provider) runWatchLoop(ctx context.Context, interval time.Duration, ch
 // If an error occurs during monitoring, the error will be logged and the
 // channel will be closed. The caller should always ensure proper cleanup by
 // cancelling the context when monitoring is no longer needed.
+//
+// Watch is a thin convenience wrapper around WatchWithOptions with
+// SmoothingAlpha set to 1 (no smoothing) and adaptive sampling disabled, so
+// it returns the same raw per-sample stats it always has.
 func (p *Provider) Watch(ctx context.Context, interval time.Duration) (<-chan *types.CPUStats, error) {
-	if err := p.validateWatchParams(interval); err != nil {
-		return nil, err
-	}
-
-	ch := p.createStatsChannel()
-	go func() {
-		defer close(ch)
-		p.runWatchLoop(ctx, interval, ch)
-	}()
-
-	return ch, nil
+	return p.WatchWithOptions(ctx, WatchOptions{
+		Interval:       interval,
+		SmoothingAlpha: 1,
+	})
 }
 
 // Shutdown cleans up resources used by the provider.
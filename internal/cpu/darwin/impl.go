@@ -16,6 +16,7 @@ package darwin
 import "C"
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -44,38 +45,66 @@ func cpuError(code int) error {
 	case errCPUSuccess:
 		return nil
 	case errCPUHostProcessorInfo:
-		return fmt.Errorf("%w: failed to get host processor information", metrics.ErrHardwareAccess)
+		return &metrics.HardwareAccessError{Op: "failed to get host processor information"}
 	case errCPUSysctl:
-		return fmt.Errorf("%w: sysctl operation failed", metrics.ErrHardwareAccess)
+		return &metrics.HardwareAccessError{Op: "sysctl operation failed"}
 	case errCPUMemory:
-		return fmt.Errorf("%w: memory allocation failed", metrics.ErrHardwareAccess)
+		return &metrics.HardwareAccessError{Op: "memory allocation failed"}
 	case errCPUMutex:
-		return fmt.Errorf("%w: mutex operation failed", metrics.ErrHardwareAccess)
+		return &metrics.HardwareAccessError{Op: "mutex operation failed"}
 	case errCPUNeedSecondSample:
-		// This is not an error, just need to wait for second sample
-		return nil
+		// Callers that hit this directly, rather than through getStats
+		// (which retries once via waitForSecondSample), get the raw
+		// transient classification so they can decide how to wait
+		// themselves.
+		return &metrics.TransientError{Op: "need a second sample to compute a usage delta", RetryAfter: initialSampleDelay}
 	default:
 		return fmt.Errorf("%w: unknown error code %d", metrics.ErrHardwareAccess, code)
 	}
 }
 
+// waitForSecondSample blocks for initialSampleDelay, the minimum gap
+// host_processor_info needs between calls before it can report a usage
+// delta, or returns ctx.Err() if ctx is cancelled first. This replaces
+// getStats' old unconditional time.Sleep-and-recurse, which blocked the
+// caller's first sample for initialSampleDelay with no way to cancel it.
+func waitForSecondSample(ctx context.Context) error {
+	timer := time.NewTimer(initialSampleDelay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // getStats returns current CPU statistics including usage, frequency, and core information.
 // For Apple Silicon Macs, this includes both performance and efficiency core metrics.
-// Returns metrics.ErrHardwareAccess if hardware information cannot be accessed.
-func getStats() (*types.CPUStats, error) {
+// Returns metrics.ErrHardwareAccess if hardware information cannot be accessed, or ctx.Err()
+// if ctx is cancelled while waiting on the second sample host_processor_info needs (see
+// waitForSecondSample).
+func getStats(ctx context.Context) (*types.CPUStats, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	numCPUs := int(C.get_cpu_count())
 	if numCPUs <= 0 {
-		return nil, fmt.Errorf("%w: failed to get CPU count", metrics.ErrHardwareAccess)
+		return nil, &metrics.HardwareAccessError{Op: "failed to get CPU count"}
 	}
 
 	var cStats C.cpu_stats_t
 	if err := int(C.get_cpu_stats(&cStats)); err != errCPUSuccess {
-		if err == errCPUNeedSecondSample {
-			// Wait for second sample
-			time.Sleep(initialSampleDelay)
-			return getStats()
+		if err != errCPUNeedSecondSample {
+			return nil, cpuError(err)
+		}
+		if waitErr := waitForSecondSample(ctx); waitErr != nil {
+			return nil, waitErr
+		}
+		if err := int(C.get_cpu_stats(&cStats)); err != errCPUSuccess {
+			return nil, cpuError(err)
 		}
-		return nil, cpuError(err)
 	}
 
 	// Get per-core stats
@@ -148,8 +177,14 @@ func getStats() (*types.CPUStats, error) {
 
 // getFrequency returns the current CPU frequency in MHz.
 // For Apple Silicon Macs, this returns the highest frequency among all cores.
-// Returns metrics.ErrHardwareAccess if the frequency cannot be determined.
-func getFrequency() (uint64, error) {
+// Returns a metrics.PermissionError if every frequency read comes back zero, which on Darwin
+// usually means the process lacks the privilege (e.g. not running as root) to read it rather
+// than a genuine hardware fault.
+func getFrequency(ctx context.Context) (uint64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
 	// Try performance cores first
 	if freq := uint64(C.get_perf_core_freq()); freq > 0 {
 		return freq, nil
@@ -163,15 +198,15 @@ func getFrequency() (uint64, error) {
 	// Fall back to traditional method
 	freq := uint64(C.get_cpu_freq())
 	if freq == 0 {
-		return 0, fmt.Errorf("%w: failed to detect CPU frequency", metrics.ErrHardwareAccess)
+		return 0, &metrics.PermissionError{Op: "detect CPU frequency"}
 	}
 	return freq, nil
 }
 
 // usage returns current CPU usage as a percentage (0-100).
 // Returns metrics.ErrHardwareAccess if usage cannot be determined.
-func usage() (float64, error) {
-	stats, err := getStats()
+func usage(ctx context.Context) (float64, error) {
+	stats, err := getStats(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get CPU usage: %w", err)
 	}
@@ -180,7 +215,11 @@ func usage() (float64, error) {
 
 // getLoadAvg returns the system load averages for the past 1, 5, and 15 minutes.
 // Returns metrics.ErrHardwareAccess if load averages cannot be determined.
-func getLoadAvg() ([3]float64, error) {
+func getLoadAvg(ctx context.Context) ([3]float64, error) {
+	if err := ctx.Err(); err != nil {
+		return [3]float64{}, err
+	}
+
 	var loadAvg [3]float64
 	if err := int(C.get_load_avg((*C.double)(&loadAvg[0]))); err != errCPUSuccess {
 		return [3]float64{}, cpuError(err)
@@ -0,0 +1,28 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"context"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// GetInterrupts returns interrupt, context-switch, syscall, and trap rates.
+//
+// Unlike Linux's /proc/stat, which perfmonger's InterruptUsage reads these
+// counters from directly, Darwin does not expose a stable, public Mach
+// (host_statistics/host_statistics64) or sysctl counter for per-second
+// interrupts, context switches, syscalls, or traps — mach_host_self's
+// HOST_VM_INFO64 and HOST_CPU_LOAD_INFO selectors cover page faults and
+// tick counts respectively, but neither exposes these scheduler/IRQ rates.
+// Until this module adds a private-API or dtrace-based collector, all four
+// fields always read 0 here.
+func (p *Provider) GetInterrupts(ctx context.Context) (*types.InterruptStats, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return &types.InterruptStats{Timestamp: time.Now()}, nil
+}
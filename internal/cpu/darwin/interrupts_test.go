@@ -0,0 +1,38 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetInterruptsAlwaysZeroOnDarwin(t *testing.T) {
+	p := NewProvider()
+	defer p.Shutdown()
+
+	stats, err := p.GetInterrupts(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+
+	assert.Zero(t, stats.Interrupts, "Darwin has no stable counter for this yet")
+	assert.Zero(t, stats.ContextSwitches)
+	assert.Zero(t, stats.Syscalls)
+	assert.Zero(t, stats.Traps)
+	assert.False(t, stats.Timestamp.IsZero())
+}
+
+func TestGetInterruptsRespectsCancelledContext(t *testing.T) {
+	p := NewProvider()
+	defer p.Shutdown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := p.GetInterrupts(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
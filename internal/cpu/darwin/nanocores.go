@@ -0,0 +1,83 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// nanoCoreDecayWindow is the time constant of the exponentially-weighted
+// moving average nanoCoreState applies, chosen to match cadvisor/Kubelet's
+// stats provider, which smooths cumulative CPU usage over roughly this
+// window rather than reporting a single noisy instantaneous delta.
+const nanoCoreDecayWindow = 10 * time.Second
+
+// nanoCoreUnit is the scale Kubernetes' CRI stats API uses for
+// UsageNanoCores: 1e9 nanocores equals one fully-saturated core.
+const nanoCoreUnit = 1_000_000_000.0
+
+// nanoCoreState tracks the smoothed CPU usage rate across successive
+// GetStats/Watch calls, following the same delta-tracking convention as
+// unnormalisedState but applying an EWMA rather than reporting the raw
+// instantaneous delta.
+type nanoCoreState struct {
+	mu            sync.Mutex
+	lastTimestamp time.Time
+	lastValue     float64
+	set           bool
+}
+
+// update folds instantaneousNanoCores (this sample's delta-based usage
+// rate) into the smoothed average and returns the new value. The first
+// call has no prior sample to decay from, so it seeds the average with
+// instantaneousNanoCores directly (0 on the very first GetStats call,
+// since applyUnnormalisedUsage itself reads 0 until a second tick sample
+// exists).
+func (s *nanoCoreState) update(instantaneousNanoCores float64, now time.Time) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.set {
+		s.lastValue = instantaneousNanoCores
+		s.lastTimestamp = now
+		s.set = true
+		return uint64(s.lastValue)
+	}
+
+	elapsed := now.Sub(s.lastTimestamp).Seconds()
+	s.lastTimestamp = now
+	if elapsed <= 0 {
+		return uint64(s.lastValue)
+	}
+
+	alpha := 1 - math.Exp(-elapsed/nanoCoreDecayWindow.Seconds())
+	s.lastValue += alpha * (instantaneousNanoCores - s.lastValue)
+	return uint64(s.lastValue)
+}
+
+// applyUsageNanoCores fills in stats.UsageNanoCores from
+// stats.TotalUsageUnnormalised, which applyUnnormalisedUsage must have
+// already populated (0..100*PhysicalCores, where 100*PhysicalCores means
+// every core fully saturated), converting it to the 1e9-per-core nanocore
+// scale and folding it into the provider's decayed moving average.
+func (p *Provider) applyUsageNanoCores(stats *types.CPUStats) {
+	instantaneous := stats.TotalUsageUnnormalised / maxCPUPercentage * nanoCoreUnit
+	stats.UsageNanoCores = p.nanoCore.update(instantaneous, stats.Timestamp)
+}
+
+// GetUsageNanoCores returns the current decayed-moving-average CPU usage
+// rate; see types.CPUStats.UsageNanoCores for units and smoothing
+// behaviour.
+func (p *Provider) GetUsageNanoCores(ctx context.Context) (uint64, error) {
+	stats, err := p.GetStats(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return stats.UsageNanoCores, nil
+}
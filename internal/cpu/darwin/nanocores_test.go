@@ -0,0 +1,40 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNanoCoreStateFirstSampleSeedsAverage(t *testing.T) {
+	var s nanoCoreState
+	now := time.Now()
+
+	got := s.update(500_000_000, now)
+	assert.Equal(t, uint64(500_000_000), got, "first sample should seed the average directly")
+}
+
+func TestNanoCoreStateDecaysTowardNewValue(t *testing.T) {
+	var s nanoCoreState
+	now := time.Now()
+
+	s.update(0, now)
+	got := s.update(nanoCoreUnit, now.Add(nanoCoreDecayWindow))
+
+	assert.Greater(t, got, uint64(0), "average should move toward the new value")
+	assert.Less(t, got, uint64(nanoCoreUnit), "average should not jump straight to the new value")
+}
+
+func TestNanoCoreStateNonPositiveElapsedKeepsLastValue(t *testing.T) {
+	var s nanoCoreState
+	now := time.Now()
+
+	s.update(300_000_000, now)
+	got := s.update(900_000_000, now)
+
+	assert.Equal(t, uint64(300_000_000), got, "a non-positive elapsed duration should not update the average")
+}
@@ -0,0 +1,41 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// ProviderOption configures optional behavior of a Provider at construction
+// time, following the functional-options pattern.
+type ProviderOption func(*Provider)
+
+// WithSampleWindow sets the minimum time that must elapse between two
+// samples before GetContainerStats will collect a fresh one, so callers
+// that poll more often than their metrics actually change (e.g. a
+// long-polling monitor) don't pay the cost of a new host_processor_info
+// call on every tick. The zero value (the default) disables this and every
+// call to GetContainerStats collects a fresh sample.
+func WithSampleWindow(d time.Duration) ProviderOption {
+	return func(p *Provider) {
+		p.sampleWindow = d
+	}
+}
+
+// NewProviderWithOptions creates a new Darwin CPU metrics provider
+// configured by opts. NewProvider is a thin convenience wrapper around this
+// with no options applied.
+func NewProviderWithOptions(opts ...ProviderOption) *Provider {
+	initCleanup()
+	p := &Provider{
+		saturation:  newSaturationRecorder(),
+		collectOpts: types.DefaultCollectOptions(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
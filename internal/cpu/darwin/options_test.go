@@ -0,0 +1,62 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+func TestWithSampleWindow(t *testing.T) {
+	p := NewProviderWithOptions(WithSampleWindow(time.Minute))
+	assert.Equal(t, time.Minute, p.sampleWindow)
+}
+
+func TestGetContainerStatsNilPrevious(t *testing.T) {
+	p := NewProviderWithOptions(WithSampleWindow(time.Hour))
+	defer p.Shutdown()
+
+	stats, err := p.GetContainerStats(context.Background(), nil)
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+}
+
+func TestGetContainerStatsWithinWindowReturnsPrevious(t *testing.T) {
+	p := NewProviderWithOptions(WithSampleWindow(time.Hour))
+	defer p.Shutdown()
+
+	previous := &types.CPUStats{TotalUsage: 42, Timestamp: time.Now()}
+
+	stats, err := p.GetContainerStats(context.Background(), previous)
+	require.NoError(t, err)
+	assert.Same(t, previous, stats, "within the sample window, previous should be returned unchanged")
+}
+
+func TestGetContainerStatsOutsideWindowCollectsFresh(t *testing.T) {
+	p := NewProviderWithOptions(WithSampleWindow(time.Millisecond))
+	defer p.Shutdown()
+
+	previous := &types.CPUStats{TotalUsage: 42, Timestamp: time.Now().Add(-time.Hour)}
+
+	stats, err := p.GetContainerStats(context.Background(), previous)
+	require.NoError(t, err)
+	assert.NotSame(t, previous, stats, "outside the sample window, a fresh sample should be collected")
+}
+
+func TestGetContainerStatsNoWindowAlwaysFresh(t *testing.T) {
+	p := NewProvider()
+	defer p.Shutdown()
+
+	previous := &types.CPUStats{TotalUsage: 42, Timestamp: time.Now()}
+
+	stats, err := p.GetContainerStats(context.Background(), previous)
+	require.NoError(t, err)
+	assert.NotSame(t, previous, stats, "with no sample window configured, GetContainerStats always collects fresh")
+}
@@ -0,0 +1,158 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#include "cpu.h"
+*/
+import "C"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// coreTickSnapshot holds the raw, cumulative tick counters for one core as
+// returned by get_cpu_core_stats, used to compute deltas between samples.
+type coreTickSnapshot struct {
+	user, system, idle, nice float64
+}
+
+// perCoreState tracks the previous per-core tick snapshot so GetPerCoreStats
+// can report deltas rather than instantaneous, cumulative-since-boot ticks.
+type perCoreState struct {
+	mu   sync.Mutex
+	prev []coreTickSnapshot
+}
+
+// GetPerCoreStats returns per-core CPU usage statistics computed from the
+// delta in tick counts since the previous call. The first call after
+// NewProvider has no prior sample to diff against, so it returns zeroed
+// percentages for every core; subsequent calls report real deltas.
+func (p *Provider) GetPerCoreStats(ctx context.Context) ([]types.CoreStats, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	numCPUs := int(C.get_cpu_count())
+	if numCPUs <= 0 {
+		return nil, cpuError(errCPUSysctl)
+	}
+
+	cCoreStats := make([]C.cpu_core_stats_t, numCPUs)
+	cNumCPUs := C.int(numCPUs)
+	if err := int(C.get_cpu_core_stats(&cCoreStats[0], &cNumCPUs)); err != errCPUSuccess {
+		return nil, cpuError(err)
+	}
+
+	platform, err := p.GetPlatform()
+	if err != nil {
+		return nil, err
+	}
+
+	cur := make([]coreTickSnapshot, numCPUs)
+	for i := 0; i < numCPUs; i++ {
+		cur[i] = coreTickSnapshot{
+			user:   float64(cCoreStats[i].user),
+			system: float64(cCoreStats[i].system),
+			idle:   float64(cCoreStats[i].idle),
+			nice:   float64(cCoreStats[i].nice),
+		}
+	}
+
+	p.perCore.mu.Lock()
+	prev := p.perCore.prev
+	p.perCore.prev = cur
+	p.perCore.mu.Unlock()
+
+	now := time.Now()
+	result := make([]types.CoreStats, numCPUs)
+	for i := 0; i < numCPUs; i++ {
+		result[i] = types.CoreStats{
+			CoreID:    i,
+			CoreType:  coreTypeForIndex(i, platform),
+			Timestamp: now,
+		}
+		if i < len(prev) {
+			result[i].User, result[i].System, result[i].Idle, result[i].Nice = tickDeltaPercentages(prev[i], cur[i])
+		}
+	}
+
+	return result, nil
+}
+
+// tickDeltaPercentages converts the delta between two tick snapshots into
+// normalized percentages. If no time has elapsed (delta total is zero), all
+// percentages are zero.
+func tickDeltaPercentages(prev, cur coreTickSnapshot) (user, system, idle, nice float64) {
+	dUser := cur.user - prev.user
+	dSystem := cur.system - prev.system
+	dIdle := cur.idle - prev.idle
+	dNice := cur.nice - prev.nice
+
+	total := dUser + dSystem + dIdle + dNice
+	if total <= 0 {
+		return 0, 0, 0, 0
+	}
+
+	return (dUser / total) * maxCPUPercentage,
+		(dSystem / total) * maxCPUPercentage,
+		(dIdle / total) * maxCPUPercentage,
+		(dNice / total) * maxCPUPercentage
+}
+
+// coreTypeForIndex classifies core i as Performance or Efficiency on Apple
+// Silicon, assuming performance cores are enumerated first, matching the
+// convention used elsewhere in this package (see examples/cpu/cpu_stats.go).
+func coreTypeForIndex(i int, platform *types.CPUPlatform) types.CoreType {
+	if !platform.IsAppleSilicon {
+		return types.CoreTypeUnknown
+	}
+	if i < platform.PerformanceCores {
+		return types.CoreTypePerformance
+	}
+	if i < platform.PerformanceCores+platform.EfficiencyCores {
+		return types.CoreTypeEfficiency
+	}
+	return types.CoreTypeUnknown
+}
+
+// WatchPerCore monitors per-core CPU statistics and sends updates through
+// the returned channel, mirroring Watch but for per-core data. The
+// returned channel is closed when the context is cancelled.
+func (p *Provider) WatchPerCore(ctx context.Context, interval time.Duration) (<-chan []types.CoreStats, error) {
+	if err := p.validateWatchParams(interval); err != nil {
+		return nil, err
+	}
+
+	ch := make(chan []types.CoreStats, 1)
+	go func() {
+		defer close(ch)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stats, err := p.GetPerCoreStats(ctx)
+				if err != nil {
+					return
+				}
+				select {
+				case ch <- stats:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
@@ -0,0 +1,31 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTickDeltaPercentages(t *testing.T) {
+	prev := coreTickSnapshot{user: 10, system: 10, idle: 80, nice: 0}
+	cur := coreTickSnapshot{user: 20, system: 10, idle: 90, nice: 0}
+
+	user, system, idle, nice := tickDeltaPercentages(prev, cur)
+	assert.InDelta(t, 50.0, user, 0.001, "10 of 20 delta ticks were user")
+	assert.InDelta(t, 0.0, system, 0.001)
+	assert.InDelta(t, 50.0, idle, 0.001)
+	assert.InDelta(t, 0.0, nice, 0.001)
+}
+
+func TestTickDeltaPercentagesNoElapsedTime(t *testing.T) {
+	snap := coreTickSnapshot{user: 10, system: 10, idle: 80, nice: 0}
+
+	user, system, idle, nice := tickDeltaPercentages(snap, snap)
+	assert.Equal(t, 0.0, user)
+	assert.Equal(t, 0.0, system)
+	assert.Equal(t, 0.0, idle)
+	assert.Equal(t, 0.0, nice)
+}
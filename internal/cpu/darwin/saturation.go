@@ -0,0 +1,87 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"sync"
+	"time"
+)
+
+// saturationWindowSize is the number of busy/idle samples retained in the
+// ring buffer used by GetProviderSaturation.
+const saturationWindowSize = 256
+
+// saturationUpdateInterval bounds how often the cached saturation value is
+// recomputed from the ring buffer.
+const saturationUpdateInterval = time.Second
+
+// saturationRecorder tracks whether the Watch loop goroutine was busy
+// (inside stats collection) or idle (waiting on the next tick) over its
+// last saturationWindowSize samples, analogous to Raft's thread saturation
+// metric. It is safe for concurrent use.
+type saturationRecorder struct {
+	mu sync.Mutex
+
+	samples [saturationWindowSize]bool
+	next    int
+	filled  int
+
+	lastComputed time.Time
+	lastValue    float64
+}
+
+// newSaturationRecorder creates an empty saturationRecorder.
+func newSaturationRecorder() *saturationRecorder {
+	return &saturationRecorder{}
+}
+
+// record appends one busy/idle sample to the ring buffer.
+func (r *saturationRecorder) record(busy bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.samples[r.next] = busy
+	r.next = (r.next + 1) % len(r.samples)
+	if r.filled < len(r.samples) {
+		r.filled++
+	}
+}
+
+// value returns the fraction of busy samples over the current window,
+// recomputed at most once per saturationUpdateInterval. If the window is
+// empty or entirely idle, the previously computed value is retained instead
+// of reporting a misleading 0, since a fully idle window usually means no
+// Watch loop has run recently rather than genuinely zero saturation.
+func (r *saturationRecorder) value() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if !r.lastComputed.IsZero() && now.Sub(r.lastComputed) < saturationUpdateInterval {
+		return r.lastValue
+	}
+
+	if r.filled == 0 {
+		return r.lastValue
+	}
+
+	var busy int
+	for i := 0; i < r.filled; i++ {
+		if r.samples[i] {
+			busy++
+		}
+	}
+	fraction := float64(busy) / float64(r.filled)
+
+	if fraction == 0 {
+		// Fully idle window: don't overwrite a previously observed
+		// value with a potentially misleading 0.
+		r.lastComputed = now
+		return r.lastValue
+	}
+
+	r.lastValue = fraction
+	r.lastComputed = now
+	return r.lastValue
+}
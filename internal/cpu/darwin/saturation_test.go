@@ -0,0 +1,41 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaturationRecorderEmpty(t *testing.T) {
+	r := newSaturationRecorder()
+	assert.Equal(t, 0.0, r.value(), "an empty recorder should report 0")
+}
+
+func TestSaturationRecorderFraction(t *testing.T) {
+	r := newSaturationRecorder()
+	r.record(true)
+	r.record(true)
+	r.record(false)
+	r.record(false)
+
+	assert.Equal(t, 0.5, r.value(), "2 busy out of 4 samples should be 0.5")
+}
+
+func TestSaturationRecorderRetainsLastValueWhenIdle(t *testing.T) {
+	r := newSaturationRecorder()
+	r.record(true)
+	first := r.value()
+	assert.Equal(t, 1.0, first)
+
+	// Overwrite the entire ring buffer with idle samples, then force
+	// recomputation past the rate limit.
+	for i := 0; i < saturationWindowSize; i++ {
+		r.record(false)
+	}
+	r.lastComputed = r.lastComputed.Add(-2 * saturationUpdateInterval)
+
+	assert.Equal(t, first, r.value(), "a fully idle window should retain the previous value")
+}
@@ -0,0 +1,144 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#include <sys/sysctl.h>
+#include <stdint.h>
+
+static int get_hw_cpufrequency(uint64_t *freq) {
+	size_t size = sizeof(*freq);
+	return sysctlbyname("hw.cpufrequency", freq, &size, NULL, 0);
+}
+*/
+import "C"
+
+import (
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// This file collects uncore/per-cluster frequency metrics, which the
+// existing FrequencyMHz/PerfFrequencyMHz/EffiFrequencyMHz fields can't
+// represent: Intel's uncore clock is a separate domain from the per-core
+// frequencies, and Apple Silicon chips with more than one P-cluster (e.g.
+// M1 Max) collapse distinct clusters into a single PerfFrequencyMHz.
+//
+// Intel uncore frequency is read directly via sysctl hw.cpufrequency,
+// which is cheap. Apple Silicon per-cluster frequency has no public
+// Mach/sysctl API, so it is read by shelling out to
+// `powermetrics --samplers cpu_power`, the same approach
+// internal/power/darwin/fallback.go uses for battery stats. powermetrics
+// requires root, so unprivileged callers get an empty
+// ClusterFrequenciesMHz rather than an error, mirroring getFrequency's
+// graceful degradation to 0. Each invocation also takes on the order of
+// the requested sample duration, so results are cached and refreshed by a
+// background goroutine rather than sampled on every GetStats call.
+const (
+	uncoreRefreshInterval = 5 * time.Second
+	clusterSampleDuration = 200 * time.Millisecond
+)
+
+// clusterHeaderRe matches a powermetrics per-cluster frequency line, e.g.
+// "E-Cluster HW active frequency: 1050 MHz" or
+// "P0-Cluster HW active frequency: 3200 MHz".
+var clusterHeaderRe = regexp.MustCompile(`^(E|P\d*)-Cluster HW active frequency:\s*(\d+)\s*MHz`)
+
+// uncoreState caches the most recently collected uncore/cluster frequency
+// sample.
+type uncoreState struct {
+	mu       sync.Mutex
+	clusters []types.ClusterFreq
+	uncore   uint64
+
+	startOnce sync.Once
+}
+
+// snapshot returns the most recently cached frequencies, starting the
+// background sampler on first use. Like applyUnnormalisedUsage, the first
+// call always sees zero values since no sample has completed yet.
+func (s *uncoreState) snapshot() ([]types.ClusterFreq, uint64) {
+	s.startOnce.Do(func() { go s.loop() })
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.clusters, s.uncore
+}
+
+// loop refreshes the cached sample on uncoreRefreshInterval until the
+// process exits; there is no way to stop it short of process exit, the
+// same lifetime as the cgo CPU stats collector it complements.
+func (s *uncoreState) loop() {
+	for {
+		s.refresh()
+		time.Sleep(uncoreRefreshInterval)
+	}
+}
+
+func (s *uncoreState) refresh() {
+	clusters := sampleClusterFrequencies()
+	uncore := sampleUncoreFrequency()
+
+	s.mu.Lock()
+	s.clusters = clusters
+	s.uncore = uncore
+	s.mu.Unlock()
+}
+
+// sampleUncoreFrequency reads hw.cpufrequency via sysctl, which exists on
+// Intel Macs only. Returns 0 on Apple Silicon, where the sysctl is absent,
+// or on any other failure.
+func sampleUncoreFrequency() uint64 {
+	var freq C.uint64_t
+	if rv := C.get_hw_cpufrequency(&freq); rv != 0 {
+		return 0
+	}
+	return uint64(freq) / 1_000_000
+}
+
+// sampleClusterFrequencies runs powermetrics once and parses per-cluster
+// active frequency from its output. Returns nil if powermetrics isn't
+// available or the caller lacks permission to run it (it requires root),
+// rather than an error, matching getFrequency's graceful fallback.
+func sampleClusterFrequencies() []types.ClusterFreq {
+	out, err := exec.Command("powermetrics", "--samplers", "cpu_power",
+		"-i", strconv.Itoa(int(clusterSampleDuration.Milliseconds())), "-n", "1").Output()
+	if err != nil {
+		return nil
+	}
+	return parsePowermetricsClusters(string(out))
+}
+
+// parsePowermetricsClusters extracts per-cluster "HW active frequency"
+// lines from powermetrics --samplers cpu_power output, e.g.:
+//
+//	E-Cluster HW active frequency: 1050 MHz
+//	P0-Cluster HW active frequency: 3200 MHz
+//	P1-Cluster HW active frequency: 3100 MHz
+func parsePowermetricsClusters(output string) []types.ClusterFreq {
+	var clusters []types.ClusterFreq
+	for _, line := range strings.Split(output, "\n") {
+		m := clusterHeaderRe.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		name := m[1]
+		mhz, _ := strconv.ParseUint(m[2], 10, 64)
+		clusterType := "P"
+		if strings.HasPrefix(name, "E") {
+			clusterType = "E"
+		}
+		clusters = append(clusters, types.ClusterFreq{
+			Name:      name,
+			Type:      clusterType,
+			ActualMHz: mhz,
+		})
+	}
+	return clusters
+}
@@ -0,0 +1,40 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const samplePowermetricsClusters = `
+E-Cluster HW active frequency: 1050 MHz
+E-Cluster idle residency: 45.2%
+P0-Cluster HW active frequency: 3200 MHz
+P1-Cluster HW active frequency: 3100 MHz
+`
+
+func TestParsePowermetricsClusters(t *testing.T) {
+	clusters := parsePowermetricsClusters(samplePowermetricsClusters)
+	require := assert.New(t)
+	require.Len(clusters, 3)
+
+	require.Equal("E", clusters[0].Name)
+	require.Equal("E", clusters[0].Type)
+	require.Equal(uint64(1050), clusters[0].ActualMHz)
+
+	require.Equal("P0", clusters[1].Name)
+	require.Equal("P", clusters[1].Type)
+	require.Equal(uint64(3200), clusters[1].ActualMHz)
+
+	require.Equal("P1", clusters[2].Name)
+	require.Equal("P", clusters[2].Type)
+	require.Equal(uint64(3100), clusters[2].ActualMHz)
+}
+
+func TestParsePowermetricsClustersEmpty(t *testing.T) {
+	clusters := parsePowermetricsClusters("no matching lines here")
+	assert.Nil(t, clusters)
+}
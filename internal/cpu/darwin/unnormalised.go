@@ -0,0 +1,115 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#include "cpu.h"
+*/
+import "C"
+
+import (
+	"sync"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// aggregateTickSnapshot holds the raw, cumulative tick counters summed
+// across every core, as returned by get_cpu_core_stats, used to compute the
+// non-normalised (Beats-style system.cpu.total.pct) usage fields.
+type aggregateTickSnapshot struct {
+	user, system, idle, nice float64
+	set                      bool
+}
+
+// unnormalisedState tracks the previous aggregate tick snapshot so
+// applyUnnormalisedUsage can report a delta across the whole machine,
+// rather than deriving it from TotalUsage's per-core average.
+type unnormalisedState struct {
+	mu   sync.Mutex
+	prev aggregateTickSnapshot
+}
+
+// applyUnnormalisedUsage fills in stats.TotalUsageUnnormalised, the
+// per-mode UserPct/SystemPct/NicePct/IdlePct fields, and their normalised
+// counterparts User/System/Idle/Nice/TotalUsage, from the delta between
+// this call's raw per-core ticks, summed across cores, and the previous
+// call's — following the same approach as telegraf/gopsutil: read
+// host_processor_info(PROCESSOR_CPU_LOAD_INFO) tick counters, cache the
+// previous snapshot, and derive percentages from delta_active /
+// delta_total, rather than (as a previous version of this package did)
+// reading a single instantaneous aggregate idle reading and treating it as
+// already a usage percentage.
+//
+// This reuses the same host_processor_info sample GetPerCoreStats already
+// fetches via get_cpu_core_stats (whose generated C side is expected to
+// vm_deallocate the kernel-returned processor_cpu_load_info array before
+// returning, as gopsutil does), but keeps its own delta tracker (rather
+// than sharing perCoreState) since the two callers shouldn't consume each
+// other's sample history. The first call after NewProvider has no prior
+// sample, so every field this method sets is left at zero — deliberately
+// not the 100%-idle reading a naive single-sample calculation would
+// otherwise produce — matching how the per-core delta fields already
+// behave before a second sample is available.
+//
+// Failures to re-read per-core ticks are treated the same way: the fields
+// are left zero rather than failing the GetStats call they populate, since
+// the rest of CPUStats remains valid either way.
+func (p *Provider) applyUnnormalisedUsage(stats *types.CPUStats) {
+	numCPUs := int(C.get_cpu_count())
+	if numCPUs <= 0 {
+		return
+	}
+
+	cCoreStats := make([]C.cpu_core_stats_t, numCPUs)
+	cNumCPUs := C.int(numCPUs)
+	if err := int(C.get_cpu_core_stats(&cCoreStats[0], &cNumCPUs)); err != errCPUSuccess {
+		return
+	}
+
+	cur := aggregateTickSnapshot{set: true}
+	for i := 0; i < numCPUs; i++ {
+		cur.user += float64(cCoreStats[i].user)
+		cur.system += float64(cCoreStats[i].system)
+		cur.idle += float64(cCoreStats[i].idle)
+		cur.nice += float64(cCoreStats[i].nice)
+	}
+
+	p.unnormalised.mu.Lock()
+	prev := p.unnormalised.prev
+	p.unnormalised.prev = cur
+	p.unnormalised.mu.Unlock()
+
+	if !prev.set {
+		// No prior sample to diff against yet: zero out every field this
+		// method owns, rather than leaving getStats' single-sample (and
+		// potentially bogus, e.g. 100%-idle) reading in place.
+		stats.User, stats.System, stats.Idle, stats.Nice, stats.TotalUsage = 0, 0, 0, 0, 0
+		stats.UserPct, stats.SystemPct, stats.IdlePct, stats.NicePct, stats.TotalUsageUnnormalised = 0, 0, 0, 0, 0
+		return
+	}
+
+	dUser := cur.user - prev.user
+	dSystem := cur.system - prev.system
+	dIdle := cur.idle - prev.idle
+	dNice := cur.nice - prev.nice
+
+	total := dUser + dSystem + dIdle + dNice
+	if total <= 0 {
+		return
+	}
+
+	unnormScale := maxCPUPercentage * float64(numCPUs) / total
+	stats.UserPct = dUser * unnormScale
+	stats.SystemPct = dSystem * unnormScale
+	stats.IdlePct = dIdle * unnormScale
+	stats.NicePct = dNice * unnormScale
+	stats.TotalUsageUnnormalised = stats.UserPct + stats.SystemPct + stats.NicePct
+
+	normScale := maxCPUPercentage / total
+	stats.User = dUser * normScale
+	stats.System = dSystem * normScale
+	stats.Idle = dIdle * normScale
+	stats.Nice = dNice * normScale
+	stats.TotalUsage = stats.User + stats.System + stats.Nice
+}
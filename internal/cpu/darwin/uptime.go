@@ -0,0 +1,57 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#include <sys/sysctl.h>
+#include <sys/time.h>
+
+static int get_boot_time(struct timeval *tv) {
+	int mib[2] = {CTL_KERN, KERN_BOOTTIME};
+	size_t size = sizeof(*tv);
+	return sysctl(mib, 2, tv, &size, NULL, 0);
+}
+*/
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+)
+
+// This file lets CPUStats and its Watch stream carry Uptime and BootTime
+// alongside CPU usage, so callers who only watch the CPU provider don't
+// need a second pkg/metrics/system.Provider just to log "up 3d 4h 12m"
+// next to usage numbers. It reads sysctl kern.boottime directly rather
+// than depending on pkg/metrics/system, since that package sits above
+// internal/cpu in the module's layering.
+
+// bootTime reads kern.boottime via sysctl.
+func bootTime() (time.Time, error) {
+	var tv C.struct_timeval
+	if rv := C.get_boot_time(&tv); rv != 0 {
+		return time.Time{}, fmt.Errorf("%w: sysctl kern.boottime failed", metrics.ErrHardwareAccess)
+	}
+	return time.Unix(int64(tv.tv_sec), int64(tv.tv_usec)*1000), nil
+}
+
+// GetBootTime returns the time the system was last booted.
+func (p *Provider) GetBootTime(ctx context.Context) (time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return time.Time{}, err
+	}
+	return bootTime()
+}
+
+// GetUptime returns the duration the system has been running since boot.
+func (p *Provider) GetUptime(ctx context.Context) (time.Duration, error) {
+	boot, err := p.GetBootTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return time.Since(boot), nil
+}
@@ -0,0 +1,42 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBootTime(t *testing.T) {
+	p := NewProvider()
+	defer p.Shutdown()
+
+	boot, err := p.GetBootTime(context.Background())
+	require.NoError(t, err)
+	assert.True(t, boot.Before(time.Now()), "boot time should be in the past")
+}
+
+func TestGetUptime(t *testing.T) {
+	p := NewProvider()
+	defer p.Shutdown()
+
+	uptime, err := p.GetUptime(context.Background())
+	require.NoError(t, err)
+	assert.Greater(t, uptime, time.Duration(0), "uptime should be > 0")
+}
+
+func TestGetBootTimeRespectsCancelledContext(t *testing.T) {
+	p := NewProvider()
+	defer p.Shutdown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := p.GetBootTime(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,199 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"context"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// defaultAdaptiveDelta is the default change in TotalUsage (percentage
+// points) between samples that is considered significant enough to shrink
+// the sampling interval when adaptive mode is enabled.
+const defaultAdaptiveDelta = 5.0
+
+// WatchOptions configures Provider.WatchWithOptions.
+type WatchOptions struct {
+	// Interval is the base sampling period. Must be positive.
+	Interval time.Duration
+
+	// SmoothingAlpha is the EWMA smoothing factor applied to each numeric
+	// field, 0 < SmoothingAlpha <= 1. A value of 1 disables smoothing and
+	// emits the raw sample every tick. Zero is treated as 1.
+	SmoothingAlpha float64
+
+	// AdaptiveMinInterval and AdaptiveMaxInterval bound the sampling
+	// interval when adaptive mode is enabled. Adaptive mode is enabled
+	// when both are positive and AdaptiveMaxInterval > AdaptiveMinInterval.
+	AdaptiveMinInterval time.Duration
+	AdaptiveMaxInterval time.Duration
+
+	// AdaptiveDelta is the minimum absolute change in smoothed TotalUsage
+	// that triggers shrinking the interval toward AdaptiveMinInterval. If
+	// zero, defaultAdaptiveDelta is used.
+	AdaptiveDelta float64
+}
+
+// adaptive reports whether adaptive interval sampling is enabled.
+func (o WatchOptions) adaptive() bool {
+	return o.AdaptiveMinInterval > 0 && o.AdaptiveMaxInterval > o.AdaptiveMinInterval
+}
+
+// alpha returns the effective smoothing factor, defaulting to 1 (no
+// smoothing) when unset.
+func (o WatchOptions) alpha() float64 {
+	if o.SmoothingAlpha <= 0 || o.SmoothingAlpha > 1 {
+		return 1
+	}
+	return o.SmoothingAlpha
+}
+
+// delta returns the effective adaptive delta threshold.
+func (o WatchOptions) delta() float64 {
+	if o.AdaptiveDelta <= 0 {
+		return defaultAdaptiveDelta
+	}
+	return o.AdaptiveDelta
+}
+
+// ewmaState tracks the smoothed value of each CPUStats field that Watch
+// smooths across samples.
+type ewmaState struct {
+	initialized bool
+	totalUsage  float64
+	user        float64
+	system      float64
+	idle        float64
+	nice        float64
+	coreUsage   []float64
+	loadAvg     [3]float64
+}
+
+// update applies one EWMA step to the tracked fields using the given raw
+// sample and smoothing factor, returning the previous TotalUsage so callers
+// can evaluate the adaptive-interval delta.
+func (s *ewmaState) update(stats *types.CPUStats, alpha float64) (prevTotalUsage float64, smoothed *types.CPUStats) {
+	ewma := func(prev, cur float64) float64 {
+		return alpha*cur + (1-alpha)*prev
+	}
+
+	if !s.initialized {
+		s.totalUsage = stats.TotalUsage
+		s.user = stats.User
+		s.system = stats.System
+		s.idle = stats.Idle
+		s.nice = stats.Nice
+		s.loadAvg = stats.LoadAvg
+		s.coreUsage = append([]float64(nil), stats.CoreUsage...)
+		s.initialized = true
+	} else {
+		prevTotalUsage = s.totalUsage
+		s.totalUsage = ewma(s.totalUsage, stats.TotalUsage)
+		s.user = ewma(s.user, stats.User)
+		s.system = ewma(s.system, stats.System)
+		s.idle = ewma(s.idle, stats.Idle)
+		s.nice = ewma(s.nice, stats.Nice)
+		for i := range s.loadAvg {
+			s.loadAvg[i] = ewma(s.loadAvg[i], stats.LoadAvg[i])
+		}
+		if len(s.coreUsage) != len(stats.CoreUsage) {
+			s.coreUsage = append([]float64(nil), stats.CoreUsage...)
+		} else {
+			for i := range s.coreUsage {
+				s.coreUsage[i] = ewma(s.coreUsage[i], stats.CoreUsage[i])
+			}
+		}
+	}
+
+	out := *stats
+	out.TotalUsage = s.totalUsage
+	out.User = s.user
+	out.System = s.system
+	out.Idle = s.idle
+	out.Nice = s.nice
+	out.LoadAvg = s.loadAvg
+	out.CoreUsage = append([]float64(nil), s.coreUsage...)
+	return prevTotalUsage, &out
+}
+
+// WatchWithOptions monitors CPU statistics like Watch, but applies EWMA
+// smoothing per WatchOptions.SmoothingAlpha and, when adaptive bounds are
+// configured, shrinks or grows the sampling interval based on how much the
+// smoothed total usage is moving between samples. This lets long-running
+// watchers reduce syscall overhead when the system is quiescent while still
+// reacting quickly to load spikes.
+func (p *Provider) WatchWithOptions(ctx context.Context, opts WatchOptions) (<-chan *types.CPUStats, error) {
+	if err := p.validateWatchParams(opts.Interval); err != nil {
+		return nil, err
+	}
+
+	ch := p.createStatsChannel()
+	go p.runAdaptiveWatchLoop(ctx, opts, ch)
+
+	return ch, nil
+}
+
+// runAdaptiveWatchLoop runs the EWMA/adaptive-interval monitoring loop.
+func (p *Provider) runAdaptiveWatchLoop(ctx context.Context, opts WatchOptions, ch chan *types.CPUStats) {
+	defer close(ch)
+
+	alpha := opts.alpha()
+	interval := opts.Interval
+	state := ewmaState{}
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			p.saturation.record(true)
+			stats, err := p.GetStats(ctx)
+			p.saturation.record(false)
+			if err == nil {
+				prevTotal, smoothed := state.update(stats, alpha)
+				p.sendStatsWithDropping(ch, smoothed)
+
+				if opts.adaptive() {
+					interval = nextAdaptiveInterval(interval, opts, prevTotal, smoothed.TotalUsage)
+				}
+			}
+			timer.Reset(interval)
+		}
+	}
+}
+
+// nextAdaptiveInterval shrinks the interval toward AdaptiveMinInterval when
+// the smoothed total usage moved by more than the configured delta since
+// the last sample, and grows it back toward AdaptiveMaxInterval otherwise.
+func nextAdaptiveInterval(current time.Duration, opts WatchOptions, prevTotal, curTotal float64) time.Duration {
+	change := curTotal - prevTotal
+	if change < 0 {
+		change = -change
+	}
+
+	const (
+		shrinkFactor = 2
+		growDivisor  = 2 // grow by 50% (current + current/growDivisor)
+	)
+
+	var next time.Duration
+	if change > opts.delta() {
+		next = current / shrinkFactor
+	} else {
+		next = current + current/growDivisor
+	}
+
+	if next < opts.AdaptiveMinInterval {
+		next = opts.AdaptiveMinInterval
+	}
+	if next > opts.AdaptiveMaxInterval {
+		next = opts.AdaptiveMaxInterval
+	}
+	return next
+}
@@ -0,0 +1,50 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+func TestEWMAStateUpdate(t *testing.T) {
+	var state ewmaState
+
+	first := &types.CPUStats{TotalUsage: 10, User: 5, System: 5}
+	_, smoothed := state.update(first, 0.5)
+	assert.Equal(t, 10.0, smoothed.TotalUsage, "first sample should seed the EWMA state")
+
+	second := &types.CPUStats{TotalUsage: 50, User: 25, System: 25}
+	prev, smoothed := state.update(second, 0.5)
+	assert.Equal(t, 10.0, prev, "prevTotalUsage should be the previous smoothed value")
+	assert.Equal(t, 30.0, smoothed.TotalUsage, "0.5*50 + 0.5*10 == 30")
+}
+
+func TestWatchOptionsDefaults(t *testing.T) {
+	opts := WatchOptions{}
+	assert.Equal(t, 1.0, opts.alpha(), "zero SmoothingAlpha should default to 1 (no smoothing)")
+	assert.Equal(t, defaultAdaptiveDelta, opts.delta(), "zero AdaptiveDelta should use the default")
+	assert.False(t, opts.adaptive(), "adaptive mode should be off without min/max bounds")
+
+	opts.AdaptiveMinInterval = 1
+	opts.AdaptiveMaxInterval = 2
+	assert.True(t, opts.adaptive(), "adaptive mode should be on once bounds are set")
+}
+
+func TestNextAdaptiveInterval(t *testing.T) {
+	opts := WatchOptions{
+		AdaptiveMinInterval: 100,
+		AdaptiveMaxInterval: 1000,
+		AdaptiveDelta:       5,
+	}
+
+	shrunk := nextAdaptiveInterval(400, opts, 10, 50)
+	assert.Less(t, int64(shrunk), int64(400), "a large change should shrink the interval")
+
+	grown := nextAdaptiveInterval(400, opts, 10, 11)
+	assert.Greater(t, int64(grown), int64(400), "a small change should grow the interval")
+}
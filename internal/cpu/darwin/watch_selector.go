@@ -0,0 +1,75 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"context"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// WatchWithSelector monitors CPU statistics like WatchFiltered, but takes
+// its interval and CoreSelector bundled into a single types.WatchOptions,
+// and additionally populates each sample's CoreUsageByIndex; see the
+// interface doc comment in api/metrics/cpu.go for what that buys callers
+// over WatchFiltered's compacted CoreUsage. Named distinctly from
+// Provider.WatchWithOptions, which already uses that name for EWMA
+// smoothing and adaptive-interval sampling and takes an unrelated,
+// package-local WatchOptions.
+func (p *Provider) WatchWithSelector(ctx context.Context, opts types.WatchOptions) (<-chan *types.CPUStats, error) {
+	if err := p.validateWatchParams(opts.Interval); err != nil {
+		return nil, err
+	}
+
+	ch := p.createStatsChannel()
+
+	go func() {
+		defer close(ch)
+
+		ticker := time.NewTicker(opts.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stats, err := p.getStatsFilteredIndexed(ctx, opts.Selector)
+				if err != nil {
+					return
+				}
+				p.sendStatsWithDropping(ch, stats)
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// getStatsFilteredIndexed is GetStatsFiltered plus CoreUsageByIndex,
+// keeping each selected core's usage under its original logical index
+// rather than compacted into selection order the way CoreUsage is.
+func (p *Provider) getStatsFilteredIndexed(ctx context.Context, sel types.CoreSelector) (*types.CPUStats, error) {
+	stats, err := p.GetStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	platform, err := p.GetPlatform()
+	if err != nil {
+		return nil, err
+	}
+
+	byIndex := make(map[int]float64, len(stats.CoreUsage))
+	for i, usage := range stats.CoreUsage {
+		if coreSelected(i, platform, sel) {
+			byIndex[i] = usage
+		}
+	}
+
+	out := applyCoreSelector(stats, platform, sel)
+	out.CoreUsageByIndex = byIndex
+	return out, nil
+}
@@ -0,0 +1,21 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+func TestWatchWithSelectorRejectsNonPositiveInterval(t *testing.T) {
+	p := NewProvider()
+	defer p.Shutdown()
+
+	_, err := p.WatchWithSelector(context.Background(), types.WatchOptions{})
+	assert.ErrorIs(t, err, types.ErrInvalidInterval)
+}
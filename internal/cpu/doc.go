@@ -1,9 +1,8 @@
-//go:build darwin
-// +build darwin
-
 // Package cpu provides a platform-agnostic interface for retrieving CPU-related
 // metrics from the system. For macOS (Darwin), it uses the host_statistics64 API
-// to gather CPU usage metrics including system, user, and idle times.
+// to gather CPU usage metrics including system, user, and idle times. For
+// FreeBSD, it shells out to sysctl(8) instead. Other platforms get a stub
+// provider whose methods all return metrics.ErrUnsupportedPlatform.
 //
 // The package is designed with the following principles:
 // - Platform independence through clear interface boundaries
@@ -45,16 +44,3 @@
 //	        stats.TotalUsage, stats.User, stats.System)
 //	}
 package cpu
-
-import (
-	"github.com/sm-moshi/dmetrics-go/api/metrics"
-	"github.com/sm-moshi/dmetrics-go/internal/cpu/darwin"
-)
-
-// NewProvider creates a new CPU metrics provider for the current platform.
-// On Darwin systems, this returns a provider that uses host_statistics64 for CPU metrics.
-// The returned provider is thread-safe and can be used concurrently.
-// Remember to call Shutdown() when done to release resources.
-func NewProvider() metrics.CPUMetrics {
-	return darwin.NewProvider()
-}
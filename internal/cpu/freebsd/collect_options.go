@@ -0,0 +1,57 @@
+//go:build freebsd
+// +build freebsd
+
+package freebsd
+
+import (
+	"context"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// WithCollectOptions sets the default types.CollectOptions used by GetStats
+// and GetStatsFiltered calls that don't go through GetStatsWithOptions
+// directly, mirroring internal/cpu/darwin's WithCollectOptions.
+func WithCollectOptions(opts types.CollectOptions) ProviderOption {
+	return func(p *Provider) {
+		p.collectOpts = opts
+	}
+}
+
+// GetStatsWithOptions returns CPU statistics like GetStats, but trims the
+// result to the fields opts selects.
+func (p *Provider) GetStatsWithOptions(ctx context.Context, opts types.CollectOptions) (*types.CPUStats, error) {
+	stats, err := p.GetStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return applyCollectOptions(stats, opts), nil
+}
+
+// applyCollectOptions returns a copy of stats trimmed to opts' selection,
+// duplicating internal/cpu/darwin's applyCollectOptions; see
+// pkg/metrics/replay's battery_curve.go for why platform packages
+// duplicate this kind of small helper rather than importing each other.
+func applyCollectOptions(stats *types.CPUStats, opts types.CollectOptions) *types.CPUStats {
+	out := *stats
+
+	if !opts.PerCPU {
+		out.CoreUsage = nil
+	}
+
+	if !opts.TotalCPU {
+		out.TotalUsage = 0
+		out.TotalUsageUnnormalised = 0
+	} else if !opts.Normalised {
+		out.TotalUsage = out.TotalUsageUnnormalised
+	}
+
+	if !opts.PerState {
+		out.User, out.System, out.Idle, out.Nice = 0, 0, 0, 0
+		out.UserPct, out.SystemPct, out.IdlePct, out.NicePct = 0, 0, 0, 0
+	} else if !opts.Normalised {
+		out.User, out.System, out.Idle, out.Nice = out.UserPct, out.SystemPct, out.IdlePct, out.NicePct
+	}
+
+	return &out
+}
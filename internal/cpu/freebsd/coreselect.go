@@ -0,0 +1,107 @@
+//go:build freebsd
+// +build freebsd
+
+package freebsd
+
+import (
+	"context"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// GetStatsFiltered returns CPU statistics like GetStats, but restricts
+// CoreUsage and TotalUsage to the logical cores selected by sel.
+// PerformanceCores/EfficiencyCores and the Apple-Silicon-only frequency
+// fields stay zero regardless of sel, since every core here is
+// CoreTypeUnknown; OnlyPerformance/OnlyEfficiency therefore select no
+// cores at all, the same as on Darwin's Intel path.
+func (p *Provider) GetStatsFiltered(ctx context.Context, sel types.CoreSelector) (*types.CPUStats, error) {
+	stats, err := p.GetStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return applyCoreSelector(stats, sel), nil
+}
+
+// WatchFiltered monitors CPU statistics like Watch, but applies sel to
+// every sample the same way GetStatsFiltered does.
+func (p *Provider) WatchFiltered(ctx context.Context, interval time.Duration, sel types.CoreSelector) (<-chan *types.CPUStats, error) {
+	if interval <= 0 {
+		return nil, types.ErrInvalidInterval
+	}
+
+	ch := make(chan *types.CPUStats, 1)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stats, err := p.GetStatsFiltered(ctx, sel)
+				if err != nil {
+					return
+				}
+				sendStatsWithDropping(ch, stats)
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// applyCoreSelector returns a copy of stats with CoreUsage compacted to
+// the cores selected by sel, and TotalUsage/PhysicalCores recomputed to
+// match, duplicating internal/cpu/darwin's applyCoreSelector (see
+// collect_options.go for why platform packages duplicate rather than
+// import this kind of helper).
+func applyCoreSelector(stats *types.CPUStats, sel types.CoreSelector) *types.CPUStats {
+	out := *stats
+
+	filtered := make([]float64, 0, len(stats.CoreUsage))
+	var usageSum float64
+	for i, usage := range stats.CoreUsage {
+		if !coreSelected(i, sel) {
+			continue
+		}
+		filtered = append(filtered, usage)
+		usageSum += usage
+	}
+
+	out.CoreUsage = filtered
+	out.PhysicalCores = len(filtered)
+	out.PerformanceCores = 0
+	out.EfficiencyCores = 0
+
+	if len(filtered) > 0 {
+		out.TotalUsage = usageSum / float64(len(filtered))
+	} else {
+		out.TotalUsage = 0
+	}
+
+	return &out
+}
+
+// coreSelected reports whether logical core i passes sel's criteria. Every
+// core here is CoreTypeUnknown, so OnlyPerformance/OnlyEfficiency exclude
+// every core when set; a zero-value CoreSelector selects every core.
+func coreSelected(i int, sel types.CoreSelector) bool {
+	if sel.OnlyPerformance || sel.OnlyEfficiency {
+		return false
+	}
+	if len(sel.Include) > 0 && !containsCoreIndex(sel.Include, i) {
+		return false
+	}
+	return !containsCoreIndex(sel.Exclude, i)
+}
+
+func containsCoreIndex(indices []int, i int) bool {
+	for _, idx := range indices {
+		if idx == i {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,362 @@
+//go:build freebsd
+// +build freebsd
+
+package freebsd
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// aggregateState tracks the previous system-wide and per-core tick
+// snapshot GetStats diffs against. It deliberately doesn't share state
+// with perCoreState in percore.go, since the two callers shouldn't
+// consume each other's sample history, mirroring
+// internal/cpu/darwin/unnormalised.go's unnormalisedState.
+type aggregateState struct {
+	mu      sync.Mutex
+	total   tickSample
+	perCore []tickSample
+	set     bool
+}
+
+// maxCPUPercentage is the upper bound of a single core's usage percentage,
+// mirroring internal/cpu/darwin.maxCPUPercentage.
+const maxCPUPercentage = 100.0
+
+// nanoCoreDecayWindow is the EWMA time constant applied by nanoCoreState,
+// mirroring internal/cpu/darwin.nanoCoreDecayWindow.
+const nanoCoreDecayWindow = 10 * time.Second
+
+// nanoCoreUnit is the scale Kubernetes' CRI stats API uses for
+// UsageNanoCores: 1e9 nanocores equals one fully-saturated core.
+const nanoCoreUnit = 1_000_000_000.0
+
+// nanoCoreState smooths TotalUsageUnnormalised into a decayed moving
+// average across successive GetStats calls, mirroring
+// internal/cpu/darwin.nanoCoreState.
+type nanoCoreState struct {
+	mu            sync.Mutex
+	lastTimestamp time.Time
+	lastValue     float64
+	set           bool
+}
+
+// update folds instantaneousNanoCores into the smoothed average and
+// returns the new value; see internal/cpu/darwin.nanoCoreState.update.
+func (s *nanoCoreState) update(instantaneousNanoCores float64, now time.Time) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.set {
+		s.lastValue = instantaneousNanoCores
+		s.lastTimestamp = now
+		s.set = true
+		return uint64(s.lastValue)
+	}
+
+	elapsed := now.Sub(s.lastTimestamp).Seconds()
+	s.lastTimestamp = now
+	if elapsed <= 0 {
+		return uint64(s.lastValue)
+	}
+
+	alpha := 1 - math.Exp(-elapsed/nanoCoreDecayWindow.Seconds())
+	s.lastValue += alpha * (instantaneousNanoCores - s.lastValue)
+	return uint64(s.lastValue)
+}
+
+// applyUsageNanoCores fills in stats.UsageNanoCores from
+// stats.TotalUsageUnnormalised, converting it to the 1e9-per-core nanocore
+// scale and folding it into the provider's decayed moving average;
+// mirrors internal/cpu/darwin.Provider.applyUsageNanoCores.
+func (p *Provider) applyUsageNanoCores(stats *types.CPUStats) {
+	instantaneous := stats.TotalUsageUnnormalised / maxCPUPercentage * nanoCoreUnit
+	stats.UsageNanoCores = p.nanoCore.update(instantaneous, stats.Timestamp)
+}
+
+// GetUsageNanoCores returns the current decayed-moving-average CPU usage
+// rate; see types.CPUStats.UsageNanoCores for units and smoothing
+// behaviour.
+func (p *Provider) GetUsageNanoCores(ctx context.Context) (uint64, error) {
+	stats, err := p.GetStats(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return stats.UsageNanoCores, nil
+}
+
+// Provider implements the CPU metrics collection for FreeBSD systems by
+// shelling out to sysctl(8). See doc.go for why this package avoids cgo.
+type Provider struct {
+	agg          aggregateState
+	perCore      perCoreState
+	interrupts   interruptState
+	nanoCore     nanoCoreState
+	sampleWindow time.Duration
+	collectOpts  types.CollectOptions
+}
+
+// var _ asserts that Provider implements metrics.CPUMetrics at compile
+// time, so a signature drift between the two fails the build here instead
+// of surfacing as an opaque "does not implement" error at a call site.
+var _ metrics.CPUMetrics = (*Provider)(nil)
+
+// NewProvider creates a new FreeBSD CPU metrics provider.
+func NewProvider() *Provider {
+	return NewProviderWithOptions()
+}
+
+// NewProviderWithOptions creates a new FreeBSD CPU metrics provider
+// configured by opts. NewProvider is a thin convenience wrapper around
+// this with no options applied.
+func NewProviderWithOptions(opts ...ProviderOption) *Provider {
+	p := &Provider{collectOpts: types.DefaultCollectOptions()}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// percentages computes the User/System/Idle/Nice percentages between two
+// tick samples, mirroring internal/cpu/darwin's unnormalised.go.
+func percentages(prev, cur tickSample) (user, system, idle, nice float64) {
+	d := cur.sub(prev)
+	total := float64(d.total())
+	if total == 0 {
+		return 0, 0, 0, 0
+	}
+	scale := 100.0 / total
+	return float64(d.user) * scale, float64(d.sys) * scale, float64(d.idle) * scale, float64(d.nice) * scale
+}
+
+// GetStats returns current CPU statistics, computed from the delta in
+// kern.cp_time/kern.cp_times counters between this call and the previous
+// one. The first call after NewProvider reports all-zero usage fields,
+// since there is no previous sample to diff against yet.
+func (p *Provider) GetStats(ctx context.Context) (*types.CPUStats, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	ncpu, err := readNCPU()
+	if err != nil {
+		return nil, err
+	}
+	total, err := readCPTime()
+	if err != nil {
+		return nil, err
+	}
+	perCore, err := readCPTimes()
+	if err != nil {
+		return nil, err
+	}
+	loadAvg, err := readLoadAvg()
+	if err != nil {
+		return nil, err
+	}
+	freq, err := readClockRateMHz()
+	if err != nil {
+		freq = 0
+	}
+
+	p.agg.mu.Lock()
+	prevTotal, prevPerCore, hadPrev := p.agg.total, p.agg.perCore, p.agg.set
+	p.agg.total = total
+	p.agg.perCore = append([]tickSample(nil), perCore...)
+	p.agg.set = true
+	p.agg.mu.Unlock()
+
+	var user, system, idle, nice float64
+	var unnormUser, unnormSystem, unnormIdle, unnormNice float64
+	coreUsage := make([]float64, len(perCore))
+	if hadPrev {
+		user, system, idle, nice = percentages(prevTotal, total)
+		for i, cur := range perCore {
+			var pc tickSample
+			if i < len(prevPerCore) {
+				pc = prevPerCore[i]
+			}
+			u, s, idl, n := percentages(pc, cur)
+			coreUsage[i] = u + s + n
+			unnormUser += u
+			unnormSystem += s
+			unnormIdle += idl
+			unnormNice += n
+		}
+	}
+	totalUsage := user + system + nice
+	unnormTotal := unnormUser + unnormSystem + unnormNice
+
+	stats := &types.CPUStats{
+		User:                   user,
+		System:                 system,
+		Idle:                   idle,
+		Nice:                   nice,
+		FrequencyMHz:           freq,
+		PhysicalCores:          ncpu,
+		CoreUsage:              coreUsage,
+		TotalUsage:             totalUsage,
+		TotalUsageUnnormalised: unnormTotal,
+		UserPct:                unnormUser,
+		SystemPct:              unnormSystem,
+		NicePct:                unnormNice,
+		IdlePct:                unnormIdle,
+		LoadAvg:                loadAvg,
+		Timestamp:              time.Now(),
+	}
+
+	if interrupts, err := p.interrupts.delta(); err == nil {
+		stats.Interrupts = interrupts.Interrupts
+		stats.ContextSwitches = interrupts.ContextSwitches
+		stats.Syscalls = interrupts.Syscalls
+		stats.Traps = interrupts.Traps
+	}
+
+	if boot, err := readBootTime(); err == nil {
+		stats.BootTime = boot
+		stats.Uptime = time.Since(boot)
+	}
+
+	p.applyUsageNanoCores(stats)
+
+	return applyCollectOptions(stats, p.collectOpts), nil
+}
+
+// GetContainerStats returns current CPU statistics, mirroring GetStats,
+// but skips collecting a fresh sample and returns previous unchanged if
+// less than the configured WithSampleWindow has elapsed since previous was
+// taken. If previous is nil, or no sample window is configured,
+// GetContainerStats always collects a fresh sample, equivalent to
+// GetStats.
+func (p *Provider) GetContainerStats(ctx context.Context, previous *types.CPUStats) (*types.CPUStats, error) {
+	if previous != nil && p.sampleWindow > 0 && time.Since(previous.Timestamp) < p.sampleWindow {
+		return previous, nil
+	}
+	return p.GetStats(ctx)
+}
+
+// GetFrequency returns the current CPU frequency in MHz, read from
+// dev.cpu.0.freq.
+func (p *Provider) GetFrequency() (uint64, error) {
+	return readClockRateMHz()
+}
+
+// GetPerformanceFrequency always returns ErrUnsupportedPlatform: FreeBSD's
+// heterogeneous-core support doesn't distinguish performance/efficiency
+// clusters the way Apple Silicon does.
+func (p *Provider) GetPerformanceFrequency() (uint64, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetEfficiencyFrequency always returns ErrUnsupportedPlatform; see
+// GetPerformanceFrequency.
+func (p *Provider) GetEfficiencyFrequency() (uint64, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetCoreCount returns the logical core count from hw.ncpu.
+func (p *Provider) GetCoreCount() (int, error) {
+	return readNCPU()
+}
+
+// GetPerformanceCoreCount always returns ErrUnsupportedPlatform; see
+// GetPerformanceFrequency.
+func (p *Provider) GetPerformanceCoreCount() (int, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetEfficiencyCoreCount always returns ErrUnsupportedPlatform; see
+// GetPerformanceFrequency.
+func (p *Provider) GetEfficiencyCoreCount() (int, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetCoreFrequencies always returns ErrUnsupportedPlatform; see
+// GetPerformanceFrequency.
+func (p *Provider) GetCoreFrequencies() ([]uint64, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// GetCoreResidencies always returns ErrUnsupportedPlatform; see
+// GetPerformanceFrequency.
+func (p *Provider) GetCoreResidencies() ([]types.CoreResidency, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// GetPlatform returns information about the CPU platform. IsAppleSilicon
+// is always false and the Apple-Silicon-only fields (PerfFrequencyMHz,
+// EffiFrequencyMHz, PerformanceCores, EfficiencyCores,
+// ClusterFrequenciesMHz) are always zero/empty.
+func (p *Provider) GetPlatform() (*types.CPUPlatform, error) {
+	model, err := readModel()
+	if err != nil {
+		return nil, err
+	}
+	freq, err := readClockRateMHz()
+	if err != nil {
+		freq = 0
+	}
+	return &types.CPUPlatform{
+		BrandString:  model,
+		FrequencyMHz: freq,
+	}, nil
+}
+
+// Watch monitors CPU statistics and sends updates through the returned
+// channel, mirroring internal/cpu/darwin.Provider.Watch. The returned
+// channel is buffered with a capacity of 1; if the consumer falls behind,
+// the oldest unread update is dropped in favour of the newest.
+func (p *Provider) Watch(ctx context.Context, interval time.Duration) (<-chan *types.CPUStats, error) {
+	if interval <= 0 {
+		return nil, types.ErrInvalidInterval
+	}
+
+	ch := make(chan *types.CPUStats, 1)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stats, err := p.GetStats(ctx)
+				if err != nil {
+					return
+				}
+				sendStatsWithDropping(ch, stats)
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Shutdown cleans up resources used by the provider; a no-op since the
+// provider holds no long-lived handles.
+func (p *Provider) Shutdown() error {
+	return nil
+}
+
+// sendStatsWithDropping sends stats to ch, dropping the oldest buffered
+// value instead of blocking if the consumer hasn't kept up.
+func sendStatsWithDropping(ch chan *types.CPUStats, stats *types.CPUStats) {
+	select {
+	case ch <- stats:
+	default:
+		select {
+		case <-ch:
+		default:
+		}
+		select {
+		case ch <- stats:
+		default:
+		}
+	}
+}
@@ -0,0 +1,11 @@
+//go:build freebsd
+// +build freebsd
+
+// Package freebsd provides FreeBSD-specific CPU metrics implementation.
+// Unlike internal/cpu/darwin, it has no cgo dependency: every reading comes
+// from shelling out to the sysctl(8) command, the same cgo-free approach
+// internal/power/darwin/fallback.go uses for Darwin when IOKit isn't
+// available. Per-mode tick counters come from kern.cp_time/kern.cp_times,
+// load averages from vm.loadavg, and frequency/model from
+// dev.cpu.0.freq/hw.model.
+package freebsd
@@ -0,0 +1,20 @@
+//go:build freebsd
+// +build freebsd
+
+package freebsd
+
+import "time"
+
+// ProviderOption configures optional behavior of a Provider at construction
+// time, mirroring internal/cpu/darwin's functional-options pattern.
+type ProviderOption func(*Provider)
+
+// WithSampleWindow sets the minimum time that must elapse between two
+// samples before GetContainerStats will collect a fresh one. The zero
+// value (the default) disables this and every call to GetContainerStats
+// collects a fresh sample.
+func WithSampleWindow(d time.Duration) ProviderOption {
+	return func(p *Provider) {
+		p.sampleWindow = d
+	}
+}
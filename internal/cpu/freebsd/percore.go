@@ -0,0 +1,89 @@
+//go:build freebsd
+// +build freebsd
+
+package freebsd
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// perCoreState tracks the previous per-core tick snapshot GetPerCoreStats
+// diffs against, kept separate from aggregateState; see its doc comment
+// for why.
+type perCoreState struct {
+	mu   sync.Mutex
+	prev []tickSample
+	set  bool
+}
+
+// GetPerCoreStats returns per-core usage statistics, computed from the
+// delta in kern.cp_times between this call and the previous one. The
+// first call after NewProvider returns zeroed percentages for every core,
+// since no prior sample exists yet. Every core reports CoreTypeUnknown:
+// FreeBSD has no heterogeneous-core distinction to report here.
+func (p *Provider) GetPerCoreStats(ctx context.Context) ([]types.CoreStats, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	cur, err := readCPTimes()
+	if err != nil {
+		return nil, err
+	}
+
+	p.perCore.mu.Lock()
+	prevPerCore, hadPrev := p.perCore.prev, p.perCore.set
+	p.perCore.prev = append([]tickSample(nil), cur...)
+	p.perCore.set = true
+	p.perCore.mu.Unlock()
+
+	now := time.Now()
+	out := make([]types.CoreStats, len(cur))
+	for i, sample := range cur {
+		out[i] = types.CoreStats{
+			CoreID:    i,
+			CoreType:  types.CoreTypeUnknown,
+			Timestamp: now,
+		}
+		if hadPrev && i < len(prevPerCore) {
+			out[i].User, out[i].System, out[i].Idle, out[i].Nice = percentages(prevPerCore[i], sample)
+		}
+	}
+	return out, nil
+}
+
+// WatchPerCore sends one GetPerCoreStats result per tick until ctx is
+// cancelled, mirroring Watch.
+func (p *Provider) WatchPerCore(ctx context.Context, interval time.Duration) (<-chan []types.CoreStats, error) {
+	if interval <= 0 {
+		return nil, types.ErrInvalidInterval
+	}
+
+	ch := make(chan []types.CoreStats)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stats, err := p.GetPerCoreStats(ctx)
+				if err != nil {
+					return
+				}
+				select {
+				case ch <- stats:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
@@ -0,0 +1,205 @@
+//go:build freebsd
+// +build freebsd
+
+package freebsd
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+)
+
+// tickSample is one kern.cp_time/kern.cp_times entry: cumulative ticks
+// since boot in FreeBSD's fixed CP_USER, CP_NICE, CP_SYS, CP_INTR, CP_IDLE
+// order.
+type tickSample struct {
+	user, nice, sys, intr, idle uint64
+}
+
+// total returns the sum of every mode, the denominator for computing
+// percentages from a delta between two samples.
+func (t tickSample) total() uint64 {
+	return t.user + t.nice + t.sys + t.intr + t.idle
+}
+
+// sub returns t-prev, saturating at zero for any mode that appears to have
+// gone backwards (e.g. a counter wrap, which ticks this infrequent never
+// hit in practice, but a negative delta would otherwise produce a bogus
+// negative percentage).
+func (t tickSample) sub(prev tickSample) tickSample {
+	return tickSample{
+		user: saturatingSub(t.user, prev.user),
+		nice: saturatingSub(t.nice, prev.nice),
+		sys:  saturatingSub(t.sys, prev.sys),
+		intr: saturatingSub(t.intr, prev.intr),
+		idle: saturatingSub(t.idle, prev.idle),
+	}
+}
+
+func saturatingSub(a, b uint64) uint64 {
+	if a < b {
+		return 0
+	}
+	return a - b
+}
+
+// sysctlOutput runs `sysctl -n name` and returns its trimmed stdout.
+func sysctlOutput(name string) (string, error) {
+	out, err := exec.Command("sysctl", "-n", name).Output()
+	if err != nil {
+		return "", fmt.Errorf("%w: sysctl -n %s: %v", metrics.ErrHardwareAccess, name, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// parseTickFields parses a whitespace-separated list of tick counters, five
+// per core, into one tickSample per core.
+func parseTickFields(fields []string) ([]tickSample, error) {
+	if len(fields)%5 != 0 {
+		return nil, fmt.Errorf("%w: tick sample has %d fields, not a multiple of 5", metrics.ErrHardwareAccess, len(fields))
+	}
+
+	samples := make([]tickSample, len(fields)/5)
+	for i := range samples {
+		vals := [5]uint64{}
+		for j := range vals {
+			v, err := strconv.ParseUint(fields[i*5+j], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: parse tick counter: %v", metrics.ErrHardwareAccess, err)
+			}
+			vals[j] = v
+		}
+		samples[i] = tickSample{user: vals[0], nice: vals[1], sys: vals[2], intr: vals[3], idle: vals[4]}
+	}
+	return samples, nil
+}
+
+// readCPTime reads the system-wide kern.cp_time counters.
+func readCPTime() (tickSample, error) {
+	out, err := sysctlOutput("kern.cp_time")
+	if err != nil {
+		return tickSample{}, err
+	}
+	samples, err := parseTickFields(strings.Fields(out))
+	if err != nil {
+		return tickSample{}, err
+	}
+	if len(samples) != 1 {
+		return tickSample{}, fmt.Errorf("%w: kern.cp_time returned %d samples, want 1", metrics.ErrHardwareAccess, len(samples))
+	}
+	return samples[0], nil
+}
+
+// readCPTimes reads the per-core kern.cp_times counters, one tickSample
+// per logical core.
+func readCPTimes() ([]tickSample, error) {
+	out, err := sysctlOutput("kern.cp_times")
+	if err != nil {
+		return nil, err
+	}
+	return parseTickFields(strings.Fields(out))
+}
+
+// readNCPU reads the logical core count from hw.ncpu.
+func readNCPU() (int, error) {
+	out, err := sysctlOutput("hw.ncpu")
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(out)
+	if err != nil {
+		return 0, fmt.Errorf("%w: parse hw.ncpu: %v", metrics.ErrHardwareAccess, err)
+	}
+	return n, nil
+}
+
+// readClockRateMHz reads the current frequency of CPU 0 from
+// dev.cpu.0.freq, which FreeBSD's powerd(8)/cpufreq(4) keep up to date.
+func readClockRateMHz() (uint64, error) {
+	out, err := sysctlOutput("dev.cpu.0.freq")
+	if err != nil {
+		return 0, err
+	}
+	freq, err := strconv.ParseUint(out, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: parse dev.cpu.0.freq: %v", metrics.ErrHardwareAccess, err)
+	}
+	return freq, nil
+}
+
+// readModel reads the CPU brand string from hw.model.
+func readModel() (string, error) {
+	return sysctlOutput("hw.model")
+}
+
+// loadAvgRe matches the three fixed-point fields `sysctl -n vm.loadavg`
+// prints, e.g. "{ 0.12 0.34 0.56 }".
+var loadAvgRe = regexp.MustCompile(`(\d+\.\d+)\s+(\d+\.\d+)\s+(\d+\.\d+)`)
+
+// readLoadAvg reads the 1/5/15-minute load averages from vm.loadavg. This
+// is used in place of the cgo-only getloadavg(3) libc call so the package
+// stays cgo-free; vm.loadavg is the same fixed-point data getloadavg reads.
+func readLoadAvg() ([3]float64, error) {
+	out, err := sysctlOutput("vm.loadavg")
+	if err != nil {
+		return [3]float64{}, err
+	}
+	m := loadAvgRe.FindStringSubmatch(out)
+	if m == nil {
+		return [3]float64{}, fmt.Errorf("%w: unexpected vm.loadavg output %q", metrics.ErrHardwareAccess, out)
+	}
+	var avg [3]float64
+	for i := range avg {
+		avg[i], err = strconv.ParseFloat(m[i+1], 64)
+		if err != nil {
+			return [3]float64{}, fmt.Errorf("%w: parse vm.loadavg: %v", metrics.ErrHardwareAccess, err)
+		}
+	}
+	return avg, nil
+}
+
+// bootTimeRe matches the `sec = N` field of `sysctl -n kern.boottime`,
+// e.g. "{ sec = 1700000000, usec = 123456 } Tue Jan ...".
+var bootTimeRe = regexp.MustCompile(`sec\s*=\s*(\d+)`)
+
+// readBootTime reads the system boot time from kern.boottime.
+func readBootTime() (time.Time, error) {
+	out, err := sysctlOutput("kern.boottime")
+	if err != nil {
+		return time.Time{}, err
+	}
+	m := bootTimeRe.FindStringSubmatch(out)
+	if m == nil {
+		return time.Time{}, fmt.Errorf("%w: unexpected kern.boottime output %q", metrics.ErrHardwareAccess, out)
+	}
+	sec, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: parse kern.boottime: %v", metrics.ErrHardwareAccess, err)
+	}
+	return time.Unix(sec, 0), nil
+}
+
+// vmStatRe matches the bare integer `sysctl -n` prints for a single
+// counter, e.g. vm.stats.sys.v_intr.
+var vmStatRe = regexp.MustCompile(`^\d+$`)
+
+// readVMStatCounter reads a single vm.stats.sys.* cumulative counter.
+func readVMStatCounter(name string) (uint64, error) {
+	out, err := sysctlOutput(name)
+	if err != nil {
+		return 0, err
+	}
+	if !vmStatRe.MatchString(out) {
+		return 0, fmt.Errorf("%w: unexpected %s output %q", metrics.ErrHardwareAccess, name, out)
+	}
+	v, err := strconv.ParseUint(out, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: parse %s: %v", metrics.ErrHardwareAccess, name, err)
+	}
+	return v, nil
+}
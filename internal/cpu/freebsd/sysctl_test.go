@@ -0,0 +1,51 @@
+//go:build freebsd
+// +build freebsd
+
+package freebsd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTickFieldsSingleCore(t *testing.T) {
+	samples, err := parseTickFields([]string{"100", "10", "50", "5", "835"})
+	require.NoError(t, err)
+	require.Len(t, samples, 1)
+	assert.Equal(t, tickSample{user: 100, nice: 10, sys: 50, intr: 5, idle: 835}, samples[0])
+}
+
+func TestParseTickFieldsMultiCore(t *testing.T) {
+	samples, err := parseTickFields([]string{
+		"100", "10", "50", "5", "835",
+		"200", "20", "60", "6", "714",
+	})
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+	assert.Equal(t, uint64(200), samples[1].user)
+}
+
+func TestParseTickFieldsRejectsWrongCount(t *testing.T) {
+	_, err := parseTickFields([]string{"1", "2", "3"})
+	assert.Error(t, err)
+}
+
+func TestTickSampleSubSaturatesAtZero(t *testing.T) {
+	cur := tickSample{user: 5}
+	prev := tickSample{user: 10}
+	assert.Equal(t, uint64(0), cur.sub(prev).user)
+}
+
+func TestLoadAvgRegexExtractsThreeFields(t *testing.T) {
+	m := loadAvgRe.FindStringSubmatch("{ 0.12 1.34 2.56 }")
+	require.NotNil(t, m)
+	assert.Equal(t, []string{"0.12", "1.34", "2.56"}, m[1:])
+}
+
+func TestBootTimeRegexExtractsSeconds(t *testing.T) {
+	m := bootTimeRe.FindStringSubmatch("{ sec = 1700000000, usec = 123456 } Tue Jan  1 00:00:00 2024")
+	require.NotNil(t, m)
+	assert.Equal(t, "1700000000", m[1])
+}
@@ -0,0 +1,28 @@
+//go:build freebsd
+// +build freebsd
+
+package freebsd
+
+import (
+	"context"
+	"time"
+)
+
+// GetBootTime returns the time the system was last booted, mirroring
+// internal/cpu/darwin.Provider.GetBootTime.
+func (p *Provider) GetBootTime(ctx context.Context) (time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return time.Time{}, err
+	}
+	return readBootTime()
+}
+
+// GetUptime returns the duration the system has been running since boot;
+// see GetBootTime.
+func (p *Provider) GetUptime(ctx context.Context) (time.Duration, error) {
+	boot, err := p.GetBootTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return time.Since(boot), nil
+}
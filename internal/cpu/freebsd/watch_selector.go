@@ -0,0 +1,61 @@
+//go:build freebsd
+// +build freebsd
+
+package freebsd
+
+import (
+	"context"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// WatchWithSelector monitors CPU statistics like WatchFiltered, but takes
+// its interval and CoreSelector bundled into a single types.WatchOptions,
+// and additionally populates each sample's CoreUsageByIndex, mirroring
+// internal/cpu/darwin.Provider.WatchWithSelector.
+func (p *Provider) WatchWithSelector(ctx context.Context, opts types.WatchOptions) (<-chan *types.CPUStats, error) {
+	if opts.Interval <= 0 {
+		return nil, types.ErrInvalidInterval
+	}
+
+	ch := make(chan *types.CPUStats, 1)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(opts.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stats, err := p.getStatsFilteredIndexed(ctx, opts.Selector)
+				if err != nil {
+					return
+				}
+				sendStatsWithDropping(ch, stats)
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// getStatsFilteredIndexed is GetStatsFiltered plus CoreUsageByIndex; see
+// internal/cpu/darwin's equivalent.
+func (p *Provider) getStatsFilteredIndexed(ctx context.Context, sel types.CoreSelector) (*types.CPUStats, error) {
+	stats, err := p.GetStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byIndex := make(map[int]float64, len(stats.CoreUsage))
+	for i, usage := range stats.CoreUsage {
+		if coreSelected(i, sel) {
+			byIndex[i] = usage
+		}
+	}
+
+	out := applyCoreSelector(stats, sel)
+	out.CoreUsageByIndex = byIndex
+	return out, nil
+}
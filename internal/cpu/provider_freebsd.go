@@ -0,0 +1,39 @@
+//go:build freebsd
+// +build freebsd
+
+package cpu
+
+import (
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/internal/cpu/freebsd"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// ProviderOption configures optional behavior of a Provider at construction
+// time. See WithSampleWindow and WithCollectOptions.
+type ProviderOption = freebsd.ProviderOption
+
+// WithSampleWindow sets the minimum time that must elapse between two
+// samples before GetContainerStats will collect a fresh one.
+func WithSampleWindow(d time.Duration) ProviderOption {
+	return freebsd.WithSampleWindow(d)
+}
+
+// WithCollectOptions sets the default types.CollectOptions used by GetStats
+// and GetStatsFiltered calls that don't go through GetStatsWithOptions
+// directly. If not passed, the provider behaves as if
+// types.DefaultCollectOptions() were given: every field is populated.
+func WithCollectOptions(opts types.CollectOptions) ProviderOption {
+	return freebsd.WithCollectOptions(opts)
+}
+
+// NewProvider creates a new CPU metrics provider for the current platform.
+// On FreeBSD systems, this returns a provider that reads sysctl(8) output
+// for CPU metrics.
+// The returned provider is thread-safe and can be used concurrently.
+// Remember to call Shutdown() when done to release resources.
+func NewProvider(opts ...ProviderOption) metrics.CPUMetrics {
+	return freebsd.NewProviderWithOptions(opts...)
+}
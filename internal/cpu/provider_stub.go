@@ -0,0 +1,39 @@
+//go:build !darwin && !freebsd
+// +build !darwin,!freebsd
+
+package cpu
+
+import (
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/internal/cpu/stub"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// ProviderOption configures optional behavior of a Provider at construction
+// time. The stub provider has no configurable behavior, so every option is
+// a no-op; it exists only so callers don't need a build-tag-specific import
+// to call NewProvider with the same signature on every platform.
+type ProviderOption func(*stub.Provider)
+
+// WithSampleWindow is a no-op on this platform; see ProviderOption.
+func WithSampleWindow(time.Duration) ProviderOption {
+	return func(*stub.Provider) {}
+}
+
+// WithCollectOptions is a no-op on this platform; see ProviderOption.
+func WithCollectOptions(types.CollectOptions) ProviderOption {
+	return func(*stub.Provider) {}
+}
+
+// NewProvider creates a new CPU metrics provider for the current platform.
+// This platform has no native CPU metrics backend, so every method on the
+// returned provider returns metrics.ErrUnsupportedPlatform.
+func NewProvider(opts ...ProviderOption) metrics.CPUMetrics {
+	p := stub.NewProvider()
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
@@ -43,7 +43,7 @@ func TestProviderCoreCount(t *testing.T) {
 func TestProviderStats(t *testing.T) {
 	provider := cpu.NewProvider()
 
-	stats, err := provider.GetStats()
+	stats, err := provider.GetStats(context.Background())
 	require.NoError(t, err)
 	require.NotNil(t, stats)
 
@@ -0,0 +1,141 @@
+//go:build !darwin && !freebsd
+// +build !darwin,!freebsd
+
+package stub
+
+import (
+	"context"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// Provider implements metrics.CPUMetrics for platforms without a native CPU
+// metrics backend. Every method returns metrics.ErrUnsupportedPlatform.
+type Provider struct{}
+
+// var _ asserts that Provider implements metrics.CPUMetrics at compile
+// time, so a signature drift between the two fails the build here instead
+// of surfacing as an opaque "does not implement" error at a call site.
+var _ metrics.CPUMetrics = (*Provider)(nil)
+
+// NewProvider creates a new stub CPU metrics provider.
+func NewProvider() *Provider {
+	return &Provider{}
+}
+
+// GetStats always returns ErrUnsupportedPlatform.
+func (p *Provider) GetStats(context.Context) (*types.CPUStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// GetContainerStats always returns ErrUnsupportedPlatform.
+func (p *Provider) GetContainerStats(context.Context, *types.CPUStats) (*types.CPUStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// GetPerCoreStats always returns ErrUnsupportedPlatform.
+func (p *Provider) GetPerCoreStats(context.Context) ([]types.CoreStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// WatchPerCore always returns ErrUnsupportedPlatform.
+func (p *Provider) WatchPerCore(context.Context, time.Duration) (<-chan []types.CoreStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// GetInterrupts always returns ErrUnsupportedPlatform.
+func (p *Provider) GetInterrupts(context.Context) (*types.InterruptStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// GetStatsWithOptions always returns ErrUnsupportedPlatform.
+func (p *Provider) GetStatsWithOptions(context.Context, types.CollectOptions) (*types.CPUStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// GetStatsFiltered always returns ErrUnsupportedPlatform.
+func (p *Provider) GetStatsFiltered(context.Context, types.CoreSelector) (*types.CPUStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// WatchFiltered always returns ErrUnsupportedPlatform.
+func (p *Provider) WatchFiltered(context.Context, time.Duration, types.CoreSelector) (<-chan *types.CPUStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// WatchWithSelector always returns ErrUnsupportedPlatform.
+func (p *Provider) WatchWithSelector(context.Context, types.WatchOptions) (<-chan *types.CPUStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// GetFrequency always returns ErrUnsupportedPlatform.
+func (p *Provider) GetFrequency() (uint64, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetPerformanceFrequency always returns ErrUnsupportedPlatform.
+func (p *Provider) GetPerformanceFrequency() (uint64, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetEfficiencyFrequency always returns ErrUnsupportedPlatform.
+func (p *Provider) GetEfficiencyFrequency() (uint64, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetCoreCount always returns ErrUnsupportedPlatform.
+func (p *Provider) GetCoreCount() (int, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetPerformanceCoreCount always returns ErrUnsupportedPlatform.
+func (p *Provider) GetPerformanceCoreCount() (int, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetEfficiencyCoreCount always returns ErrUnsupportedPlatform.
+func (p *Provider) GetEfficiencyCoreCount() (int, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetPlatform always returns ErrUnsupportedPlatform.
+func (p *Provider) GetPlatform() (*types.CPUPlatform, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// GetUsageNanoCores always returns ErrUnsupportedPlatform.
+func (p *Provider) GetUsageNanoCores(context.Context) (uint64, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetCoreFrequencies always returns ErrUnsupportedPlatform.
+func (p *Provider) GetCoreFrequencies() ([]uint64, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// GetCoreResidencies always returns ErrUnsupportedPlatform.
+func (p *Provider) GetCoreResidencies() ([]types.CoreResidency, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// GetUptime always returns ErrUnsupportedPlatform.
+func (p *Provider) GetUptime(context.Context) (time.Duration, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetBootTime always returns ErrUnsupportedPlatform.
+func (p *Provider) GetBootTime(context.Context) (time.Time, error) {
+	return time.Time{}, metrics.ErrUnsupportedPlatform
+}
+
+// Watch always returns ErrUnsupportedPlatform.
+func (p *Provider) Watch(context.Context, time.Duration) (<-chan *types.CPUStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// Shutdown is a no-op; the provider holds no resources.
+func (p *Provider) Shutdown() error {
+	return nil
+}
@@ -13,10 +13,16 @@
 //   - Design Capacity: The original design capacity of the battery
 //
 // Battery Health Calculation:
-// Battery health is determined by comparing max capacity to design capacity:
-// - Good: ≥80% of design capacity
-// - Fair: ≥50% of design capacity
-// - Poor: <50% of design capacity
+// Battery health is determined by charge-cycle count and by comparing max
+// capacity to design capacity; the worse of the two classifications wins.
+// The default thresholds, defined in DefaultHealthPolicy, are:
+// - Poor: ≥1000 charge cycles, OR <80% of design capacity
+// - Fair: 800-999 charge cycles, OR 80%-90% of design capacity
+// - Good: <800 charge cycles AND ≥90% of design capacity
+// Callers that need different thresholds (e.g. to match a vendor/MDM
+// convention) can supply their own HealthPolicy via NewProviderWithPolicy.
+// The raw capacity ratio is also available via GetBatteryHealthPercentage
+// for callers that want to apply their own thresholds entirely.
 //
 // The implementation provides:
 // - Battery status (charging state, percentage, health)
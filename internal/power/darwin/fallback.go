@@ -0,0 +1,159 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// This file implements a pure-Go battery reader that shells out to pmset
+// and ioreg instead of linking against IOKit, for use in cross-compiled or
+// sandboxed environments where cgo is unavailable. It backs getStats in
+// impl_nocgo.go (-tags nocgo builds) and is also used by impl.go as a
+// runtime fallback when the cgo/IOKit call fails.
+
+var (
+	// pmsetPercentRe matches e.g. "82%" in `pmset -g batt` output.
+	pmsetPercentRe = regexp.MustCompile(`(\d+)%`)
+
+	// pmsetTimeRe matches an "H:MM" remaining-time field, or "0:00" when
+	// pmset hasn't estimated it yet.
+	pmsetTimeRe = regexp.MustCompile(`(\d+):(\d{2})`)
+
+	// ioregCycleCountRe matches `"CycleCount" = 123`.
+	ioregCycleCountRe = regexp.MustCompile(`"CycleCount"\s*=\s*(\d+)`)
+
+	// ioregMaxCapacityRe matches `"MaxCapacity" = 4500` (mAh).
+	ioregMaxCapacityRe = regexp.MustCompile(`"MaxCapacity"\s*=\s*(\d+)`)
+
+	// ioregDesignCapacityRe matches `"DesignCapacity" = 5000` (mAh).
+	ioregDesignCapacityRe = regexp.MustCompile(`"DesignCapacity"\s*=\s*(\d+)`)
+
+	// ioregRawCurrentCapacityRe matches `"AppleRawCurrentCapacity" = 4200`.
+	ioregRawCurrentCapacityRe = regexp.MustCompile(`"AppleRawCurrentCapacity"\s*=\s*(\d+)`)
+
+	// pmsetStateFieldRe captures the charging-state phrase between the
+	// percentage and the remaining-time fields, e.g. "; discharging; " or
+	// "; finishing charge; ".
+	pmsetStateFieldRe = regexp.MustCompile(`;\s*([a-zA-Z ]+?)\s*;`)
+)
+
+// fallbackGetStats collects power and battery statistics by parsing
+// `pmset -g batt` and `ioreg -rn AppleSmartBattery` output.
+func fallbackGetStats(policy HealthPolicy) (*types.PowerStats, error) {
+	pmsetOut, err := exec.Command("pmset", "-g", "batt").Output()
+	if err != nil {
+		return nil, err
+	}
+
+	isPresent, percentage, state, source, timeRemaining := parsePmsetOutput(string(pmsetOut))
+
+	stats := &types.PowerStats{
+		IsPresent:     isPresent,
+		Percentage:    percentage,
+		State:         state,
+		Source:        source,
+		TimeRemaining: timeRemaining,
+		Timestamp:     time.Now(),
+	}
+
+	if isPresent {
+		ioregOut, err := exec.Command("ioreg", "-rn", "AppleSmartBattery").Output()
+		if err == nil {
+			cycleCount, maxCapacity, designCapacity, currentCapacity := parseIoregOutput(string(ioregOut))
+			stats.CycleCount = cycleCount
+			stats.MaxCapacity = maxCapacity
+			stats.DesignCapacity = designCapacity
+			stats.CurrentCapacity = currentCapacity
+		}
+		stats.Health = determineBatteryHealth(isPresent, stats.CycleCount, stats.MaxCapacity, stats.DesignCapacity, policy)
+	} else {
+		stats.Health = types.BatteryHealthUnknown
+	}
+
+	return stats, nil
+}
+
+// parsePmsetOutput extracts battery presence, charge percentage, state,
+// power source, and remaining time from `pmset -g batt` output, e.g.:
+//
+//	Now drawing from 'Battery Power'
+//	 -InternalBattery-0 (id=1234567)	82%; discharging; 3:45 remaining present: true
+func parsePmsetOutput(output string) (isPresent bool, percentage float64, state types.BatteryState, source types.PowerSource, timeRemaining time.Duration) {
+	isPresent = strings.Contains(output, "InternalBattery")
+	if !isPresent {
+		return false, 0, types.BatteryStateUnknown, types.PowerSourceUnknown, 0
+	}
+
+	if m := pmsetPercentRe.FindStringSubmatch(output); m != nil {
+		percentage, _ = strconv.ParseFloat(m[1], 64)
+	}
+
+	state = mapChargingState(output)
+
+	if strings.Contains(output, "AC Power") {
+		source = types.PowerSourceAC
+	} else {
+		source = types.PowerSourceBattery
+	}
+
+	if m := pmsetTimeRe.FindStringSubmatch(output); m != nil {
+		hours, _ := strconv.Atoi(m[1])
+		minutes, _ := strconv.Atoi(m[2])
+		total := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute
+		if state == types.BatteryStateCharging {
+			total = -total
+		}
+		timeRemaining = total
+	}
+
+	return isPresent, percentage, state, source, timeRemaining
+}
+
+// mapChargingState maps the charging-state phrase pmset prints between the
+// percentage and remaining-time fields (e.g. "charging", "discharging",
+// "AC attached", "charged", "finishing charge") to a types.BatteryState.
+func mapChargingState(pmsetOutput string) types.BatteryState {
+	m := pmsetStateFieldRe.FindStringSubmatch(pmsetOutput)
+	if m == nil {
+		return types.BatteryStateUnknown
+	}
+
+	switch strings.TrimSpace(m[1]) {
+	case "charged":
+		return types.BatteryStateFull
+	case "charging", "finishing charge":
+		return types.BatteryStateCharging
+	case "discharging":
+		return types.BatteryStateDischarging
+	case "AC attached", "not charging":
+		return types.BatteryStateNotCharging
+	default:
+		return types.BatteryStateUnknown
+	}
+}
+
+// parseIoregOutput extracts cycle count and capacity fields from
+// `ioreg -rn AppleSmartBattery` output.
+func parseIoregOutput(output string) (cycleCount int, maxCapacity, designCapacity, currentCapacity float64) {
+	if m := ioregCycleCountRe.FindStringSubmatch(output); m != nil {
+		cycleCount, _ = strconv.Atoi(m[1])
+	}
+	if m := ioregMaxCapacityRe.FindStringSubmatch(output); m != nil {
+		maxCapacity, _ = strconv.ParseFloat(m[1], 64)
+	}
+	if m := ioregDesignCapacityRe.FindStringSubmatch(output); m != nil {
+		designCapacity, _ = strconv.ParseFloat(m[1], 64)
+	}
+	if m := ioregRawCurrentCapacityRe.FindStringSubmatch(output); m != nil {
+		currentCapacity, _ = strconv.ParseFloat(m[1], 64)
+	}
+	return cycleCount, maxCapacity, designCapacity, currentCapacity
+}
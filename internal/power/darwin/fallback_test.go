@@ -0,0 +1,76 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+const samplePmsetDischarging = `Now drawing from 'Battery Power'
+ -InternalBattery-0 (id=4259079)	82%; discharging; 3:45 remaining present: true
+`
+
+const samplePmsetCharging = `Now drawing from 'AC Power'
+ -InternalBattery-0 (id=4259079)	55%; charging; 1:10 remaining present: true
+`
+
+const samplePmsetCharged = `Now drawing from 'AC Power'
+ -InternalBattery-0 (id=4259079)	100%; charged; 0:00 remaining present: true
+`
+
+const sampleIoreg = `    |   "CycleCount" = 312
+    |   "DesignCapacity" = 5000
+    |   "MaxCapacity" = 4500
+    |   "AppleRawCurrentCapacity" = 4200
+`
+
+func TestParsePmsetOutputDischarging(t *testing.T) {
+	present, percent, state, source, remaining := parsePmsetOutput(samplePmsetDischarging)
+	assert.True(t, present)
+	assert.Equal(t, 82.0, percent)
+	assert.Equal(t, types.BatteryStateDischarging, state)
+	assert.Equal(t, types.PowerSourceBattery, source)
+	assert.Equal(t, 3*time.Hour+45*time.Minute, remaining, "discharging time should be positive")
+}
+
+func TestParsePmsetOutputCharging(t *testing.T) {
+	present, percent, state, source, remaining := parsePmsetOutput(samplePmsetCharging)
+	assert.True(t, present)
+	assert.Equal(t, 55.0, percent)
+	assert.Equal(t, types.BatteryStateCharging, state)
+	assert.Equal(t, types.PowerSourceAC, source)
+	assert.Equal(t, -(1*time.Hour + 10*time.Minute), remaining, "charging time should be negative")
+}
+
+func TestParsePmsetOutputCharged(t *testing.T) {
+	_, _, state, _, _ := parsePmsetOutput(samplePmsetCharged)
+	assert.Equal(t, types.BatteryStateFull, state)
+}
+
+func TestParsePmsetOutputNoBattery(t *testing.T) {
+	present, _, state, _, _ := parsePmsetOutput("Now drawing from 'AC Power'\n")
+	assert.False(t, present)
+	assert.Equal(t, types.BatteryStateUnknown, state)
+}
+
+func TestParseIoregOutput(t *testing.T) {
+	cycleCount, maxCapacity, designCapacity, currentCapacity := parseIoregOutput(sampleIoreg)
+	assert.Equal(t, 312, cycleCount)
+	assert.Equal(t, 4500.0, maxCapacity)
+	assert.Equal(t, 5000.0, designCapacity)
+	assert.Equal(t, 4200.0, currentCapacity)
+}
+
+func TestMapChargingState(t *testing.T) {
+	assert.Equal(t, types.BatteryStateCharging, mapChargingState("82%; charging; 1:00 remaining"))
+	assert.Equal(t, types.BatteryStateDischarging, mapChargingState("82%; discharging; 1:00 remaining"))
+	assert.Equal(t, types.BatteryStateFull, mapChargingState("100%; charged;"))
+	assert.Equal(t, types.BatteryStateNotCharging, mapChargingState("100%; AC attached; not charging"))
+	assert.Equal(t, types.BatteryStateUnknown, mapChargingState("unparseable"))
+}
@@ -0,0 +1,123 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import "github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+
+// HealthPolicy defines the charge-cycle and capacity-ratio thresholds used
+// to classify battery health. Both axes are evaluated independently and the
+// worse of the two classifications wins, so a battery with few cycles but a
+// degraded capacity ratio (or vice versa) is still flagged correctly.
+//
+// The zero value is not usable; use DefaultHealthPolicy or construct a
+// HealthPolicy with all four fields set.
+type HealthPolicy struct {
+	// CycleCountFair is the charge-cycle count at or above which health
+	// degrades from Good to Fair.
+	CycleCountFair int
+
+	// CycleCountPoor is the charge-cycle count at or above which health
+	// degrades to Poor, regardless of capacity ratio.
+	CycleCountPoor int
+
+	// CapacityRatioGood is the minimum MaxCapacity/DesignCapacity
+	// percentage for Good health.
+	CapacityRatioGood float64
+
+	// CapacityRatioFair is the minimum MaxCapacity/DesignCapacity
+	// percentage for Fair health; below this, health is Poor.
+	CapacityRatioFair float64
+}
+
+// batteryHealthPercentMultiplier converts capacity ratios to percentages.
+const batteryHealthPercentMultiplier = 100.0
+
+// batteryHealthPercentage returns the raw MaxCapacity/DesignCapacity ratio
+// as a percentage, and false if either capacity value is unavailable.
+func batteryHealthPercentage(maxCapacity, designCapacity float64) (float64, bool) {
+	if maxCapacity <= 0 || designCapacity <= 0 {
+		return 0, false
+	}
+	return (maxCapacity / designCapacity) * batteryHealthPercentMultiplier, true
+}
+
+// determineBatteryHealth classifies battery health from the charge-cycle
+// count and the MaxCapacity/DesignCapacity ratio, per policy. Both axes are
+// classified independently and the worse of the two wins. Returns
+// BatteryHealthUnknown when the battery is absent or capacity data is
+// missing.
+func determineBatteryHealth(isPresent bool, cycleCount int, maxCapacity, designCapacity float64, policy HealthPolicy) types.BatteryHealth {
+	if !isPresent {
+		return types.BatteryHealthUnknown
+	}
+
+	healthPercent, ok := batteryHealthPercentage(maxCapacity, designCapacity)
+	if !ok {
+		return types.BatteryHealthUnknown
+	}
+
+	byCycles := policy.classifyCycles(cycleCount)
+	byCapacity := policy.classifyCapacityRatio(healthPercent)
+	return worseHealth(byCycles, byCapacity)
+}
+
+// DefaultHealthPolicy returns the thresholds this package has always used:
+// Poor below 80% capacity or above 1000 cycles, Fair below 90% capacity or
+// above 800 cycles, Good otherwise.
+func DefaultHealthPolicy() HealthPolicy {
+	return HealthPolicy{
+		CycleCountFair:    800,
+		CycleCountPoor:    1000,
+		CapacityRatioGood: 90.0,
+		CapacityRatioFair: 80.0,
+	}
+}
+
+// classifyCycles applies the cycle-count axis of the policy.
+func (p HealthPolicy) classifyCycles(cycleCount int) types.BatteryHealth {
+	switch {
+	case cycleCount >= p.CycleCountPoor:
+		return types.BatteryHealthPoor
+	case cycleCount >= p.CycleCountFair:
+		return types.BatteryHealthFair
+	default:
+		return types.BatteryHealthGood
+	}
+}
+
+// classifyCapacityRatio applies the capacity-ratio axis of the policy.
+func (p HealthPolicy) classifyCapacityRatio(ratioPercent float64) types.BatteryHealth {
+	switch {
+	case ratioPercent < p.CapacityRatioFair:
+		return types.BatteryHealthPoor
+	case ratioPercent < p.CapacityRatioGood:
+		return types.BatteryHealthFair
+	default:
+		return types.BatteryHealthGood
+	}
+}
+
+// healthSeverity ranks BatteryHealth values so the worse of two
+// classifications can be picked; higher is worse.
+func healthSeverity(h types.BatteryHealth) int {
+	switch h {
+	case types.BatteryHealthGood:
+		return 0
+	case types.BatteryHealthFair:
+		return 1
+	case types.BatteryHealthPoor:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// worseHealth returns whichever of a and b is the more severe
+// classification.
+func worseHealth(a, b types.BatteryHealth) types.BatteryHealth {
+	if healthSeverity(b) > healthSeverity(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,55 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+func TestHealthPolicyClassifyCycles(t *testing.T) {
+	policy := DefaultHealthPolicy()
+
+	assert.Equal(t, types.BatteryHealthGood, policy.classifyCycles(0))
+	assert.Equal(t, types.BatteryHealthFair, policy.classifyCycles(800))
+	assert.Equal(t, types.BatteryHealthFair, policy.classifyCycles(999))
+	assert.Equal(t, types.BatteryHealthPoor, policy.classifyCycles(1000))
+}
+
+func TestHealthPolicyClassifyCapacityRatio(t *testing.T) {
+	policy := DefaultHealthPolicy()
+
+	assert.Equal(t, types.BatteryHealthGood, policy.classifyCapacityRatio(95))
+	assert.Equal(t, types.BatteryHealthFair, policy.classifyCapacityRatio(85))
+	assert.Equal(t, types.BatteryHealthPoor, policy.classifyCapacityRatio(70))
+}
+
+func TestHealthPolicyWorseAxisWins(t *testing.T) {
+	policy := DefaultHealthPolicy()
+
+	// High cycle count but pristine capacity ratio: cycles should dominate.
+	byCycles := policy.classifyCycles(1200)
+	byCapacity := policy.classifyCapacityRatio(98)
+	assert.Equal(t, types.BatteryHealthPoor, worseHealth(byCycles, byCapacity))
+
+	// Low cycle count but degraded capacity ratio: capacity should dominate.
+	byCycles = policy.classifyCycles(10)
+	byCapacity = policy.classifyCapacityRatio(70)
+	assert.Equal(t, types.BatteryHealthPoor, worseHealth(byCycles, byCapacity))
+}
+
+func TestCustomHealthPolicy(t *testing.T) {
+	policy := HealthPolicy{
+		CycleCountFair:    100,
+		CycleCountPoor:    200,
+		CapacityRatioGood: 95,
+		CapacityRatioFair: 90,
+	}
+
+	assert.Equal(t, types.BatteryHealthFair, policy.classifyCycles(150))
+	assert.Equal(t, types.BatteryHealthGood, policy.classifyCapacityRatio(96))
+}
@@ -0,0 +1,14 @@
+//go:build darwin && nocgo
+// +build darwin,nocgo
+
+package darwin
+
+import "github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+
+// getStats retrieves power and battery statistics via the pure-Go
+// pmset/ioreg parser in fallback.go. This build tag excludes impl.go's
+// cgo/IOKit implementation entirely, for cross-compiled or sandboxed
+// environments where cgo is unavailable.
+func getStats(policy HealthPolicy) (*types.PowerStats, error) {
+	return fallbackGetStats(policy)
+}
@@ -15,7 +15,7 @@ import (
 )
 
 func TestGetStatsImpl(t *testing.T) {
-	stats, err := getStats()
+	stats, err := getStats(DefaultHealthPolicy())
 	require.NoError(t, err)
 	require.NotNil(t, stats)
 
@@ -30,13 +30,13 @@ func TestGetStatsImpl(t *testing.T) {
 }
 
 func TestGetPowerSourceImpl(t *testing.T) {
-	source, err := getPowerSource()
+	source, err := getPowerSource(DefaultHealthPolicy())
 	require.NoError(t, err)
 	assert.NotEqual(t, types.PowerSourceUnknown, source, "Power source should be determinable")
 }
 
 func TestGetBatteryPercentageImpl(t *testing.T) {
-	percentage, err := getBatteryPercentage()
+	percentage, err := getBatteryPercentage(DefaultHealthPolicy())
 	if errors.Is(err, types.ErrNoBattery) {
 		t.Skip("No battery present, skipping test")
 	}
@@ -46,7 +46,7 @@ func TestGetBatteryPercentageImpl(t *testing.T) {
 }
 
 func TestGetBatteryStateImpl(t *testing.T) {
-	state, err := getBatteryState()
+	state, err := getBatteryState(DefaultHealthPolicy())
 	require.NoError(t, err)
 
 	// State should be one of the defined states
@@ -60,7 +60,7 @@ func TestGetBatteryStateImpl(t *testing.T) {
 }
 
 func TestGetBatteryHealthImpl(t *testing.T) {
-	health, err := getBatteryHealth()
+	health, err := getBatteryHealth(DefaultHealthPolicy())
 	if errors.Is(err, types.ErrNoBattery) {
 		t.Skip("No battery present, skipping test")
 	}
@@ -77,7 +77,7 @@ func TestGetBatteryHealthImpl(t *testing.T) {
 }
 
 func TestGetTimeRemainingImpl(t *testing.T) {
-	stats, err := getStats()
+	stats, err := getStats(DefaultHealthPolicy())
 	if errors.Is(err, types.ErrNoBattery) {
 		t.Skip("No battery present, skipping test")
 	}
@@ -85,7 +85,7 @@ func TestGetTimeRemainingImpl(t *testing.T) {
 
 	// Only verify time remaining if we have a battery
 	if stats.IsPresent {
-		timeRemaining, err := getTimeRemaining()
+		timeRemaining, err := getTimeRemaining(DefaultHealthPolicy())
 		require.NoError(t, err)
 
 		// Time remaining can be negative when charging
@@ -100,7 +100,7 @@ func TestGetTimeRemainingImpl(t *testing.T) {
 }
 
 func TestGetPowerConsumptionImpl(t *testing.T) {
-	power, err := getPowerConsumption()
+	power, err := getPowerConsumption(DefaultHealthPolicy())
 	require.NoError(t, err)
 	assert.GreaterOrEqual(t, power, 0.0)
 }
@@ -108,7 +108,7 @@ func TestGetPowerConsumptionImpl(t *testing.T) {
 func TestSMCInitialization(t *testing.T) {
 	// Test SMC initialization (already done in init())
 	// Just verify we can get power info
-	stats, err := getStats()
+	stats, err := getStats(DefaultHealthPolicy())
 	require.NoError(t, err)
 	assert.NotNil(t, stats)
 
@@ -119,7 +119,7 @@ func TestSMCInitialization(t *testing.T) {
 }
 
 func TestBatteryHealthCalculation(t *testing.T) {
-	stats, err := getStats()
+	stats, err := getStats(DefaultHealthPolicy())
 	if errors.Is(err, types.ErrNoBattery) {
 		t.Skip("No battery present, skipping test")
 	}
@@ -137,7 +137,7 @@ func TestBatteryHealthCalculation(t *testing.T) {
 		}
 
 		// Test health calculation
-		health, err := getBatteryHealth()
+		health, err := getBatteryHealth(DefaultHealthPolicy())
 		require.NoError(t, err)
 
 		// Health should correlate with capacity ratio
@@ -147,14 +147,14 @@ func TestBatteryHealthCalculation(t *testing.T) {
 		// Verify that we got a valid health status
 		assert.NotEqual(t, types.BatteryHealthUnknown, health, "health status should not be unknown")
 
-		// Verify that the health status matches the percentage
-		switch {
-		case healthPercent >= 80:
-			assert.Equal(t, types.BatteryHealthGood, health)
-		case healthPercent >= 50:
-			assert.Equal(t, types.BatteryHealthFair, health)
-		default:
-			assert.Equal(t, types.BatteryHealthPoor, health)
-		}
+		// Verify that the health status matches the cycle-count + capacity-ratio
+		// model: the worse of the two independent classifications wins.
+		policy := DefaultHealthPolicy()
+		expected := worseHealth(policy.classifyCycles(stats.CycleCount), policy.classifyCapacityRatio(healthPercent))
+		assert.Equal(t, expected, health)
+
+		percentage, err := getBatteryHealthPercentage(DefaultHealthPolicy())
+		require.NoError(t, err)
+		assert.InDelta(t, healthPercent, percentage, 0.01, "GetBatteryHealthPercentage should match the raw capacity ratio")
 	}
 }
@@ -14,12 +14,21 @@ import (
 
 // Provider implements the power metrics collection for Darwin systems.
 type Provider struct {
-	mu sync.RWMutex
+	mu     sync.RWMutex
+	policy HealthPolicy
 }
 
-// NewProvider creates a new Darwin power metrics provider.
+// NewProvider creates a new Darwin power metrics provider using
+// DefaultHealthPolicy for battery health classification.
 func NewProvider() *Provider {
-	return &Provider{}
+	return NewProviderWithPolicy(DefaultHealthPolicy())
+}
+
+// NewProviderWithPolicy creates a new Darwin power metrics provider that
+// classifies battery health according to policy, so callers can match
+// vendor/MDM conventions without forking the package.
+func NewProviderWithPolicy(policy HealthPolicy) *Provider {
+	return &Provider{policy: policy}
 }
 
 // GetStats returns current power and battery statistics.
@@ -29,7 +38,7 @@ func (p *Provider) GetStats(ctx context.Context) (*types.PowerStats, error) {
 	}
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	return getStats()
+	return getStats(p.policy)
 }
 
 // GetPowerSource returns the current power source.
@@ -39,7 +48,7 @@ func (p *Provider) GetPowerSource(ctx context.Context) (types.PowerSource, error
 	}
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	return getPowerSource()
+	return getPowerSource(p.policy)
 }
 
 // GetBatteryPercentage returns the current battery charge percentage.
@@ -49,7 +58,7 @@ func (p *Provider) GetBatteryPercentage(ctx context.Context) (float64, error) {
 	}
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	return getBatteryPercentage()
+	return getBatteryPercentage(p.policy)
 }
 
 // GetBatteryPresent returns whether a battery is present in the system.
@@ -57,7 +66,7 @@ func (p *Provider) GetBatteryPresent(context.Context) (bool, error) {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
 
-	stats, err := getStats()
+	stats, err := getStats(p.policy)
 	if err != nil {
 		return false, err
 	}
@@ -71,7 +80,7 @@ func (p *Provider) GetBatteryState(ctx context.Context) (types.BatteryState, err
 	}
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	return getBatteryState()
+	return getBatteryState(p.policy)
 }
 
 // GetBatteryHealth returns the current battery health status.
@@ -81,7 +90,19 @@ func (p *Provider) GetBatteryHealth(ctx context.Context) (types.BatteryHealth, e
 	}
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	return getBatteryHealth()
+	return getBatteryHealth(p.policy)
+}
+
+// GetBatteryHealthPercentage returns the raw MaxCapacity/DesignCapacity
+// ratio as a percentage (0-100), independent of the CycleCount-based
+// classification returned by GetBatteryHealth.
+func (p *Provider) GetBatteryHealthPercentage(ctx context.Context) (float64, error) {
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return getBatteryHealthPercentage(p.policy)
 }
 
 // GetTimeRemaining returns the estimated time remaining on battery power.
@@ -91,7 +112,7 @@ func (p *Provider) GetTimeRemaining(ctx context.Context) (time.Duration, error)
 	}
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	return getTimeRemaining()
+	return getTimeRemaining(p.policy)
 }
 
 // GetPowerConsumption returns the current system power consumption.
@@ -101,7 +122,7 @@ func (p *Provider) GetPowerConsumption(ctx context.Context) (float64, error) {
 	}
 	p.mu.RLock()
 	defer p.mu.RUnlock()
-	return getPowerConsumption()
+	return getPowerConsumption(p.policy)
 }
 
 // GetBatteryCharging returns whether the battery is currently charging.
@@ -112,7 +133,7 @@ func (p *Provider) GetBatteryCharging(ctx context.Context) (bool, error) {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
 
-	stats, err := getStats()
+	stats, err := getStats(p.policy)
 	if err != nil {
 		return false, err
 	}
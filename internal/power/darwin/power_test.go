@@ -12,9 +12,32 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/mock"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/replay"
 	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
 )
 
+// batteryProviderCase pairs a metrics.PowerMetrics with the label its
+// subtest should run under.
+type batteryProviderCase struct {
+	name     string
+	provider metrics.PowerMetrics
+}
+
+// batteryProviders returns the live cgo provider alongside a replay
+// provider driven by a synthetic discharge-then-recharge curve, so
+// battery-path tests exercise real hardware when it's present (skipping
+// via ErrNoBattery otherwise) and always exercise the replay path, which
+// has a battery on every machine including CI containers.
+func batteryProviders(t *testing.T) []batteryProviderCase {
+	t.Helper()
+	return []batteryProviderCase{
+		{name: "live", provider: NewProvider()},
+		{name: "replay", provider: mock.NewPowerProvider(replay.SimulatedBatteryCurve(replay.DefaultBatteryCurveOptions()))},
+	}
+}
+
 func TestNewProvider(t *testing.T) {
 	provider := NewProvider()
 	assert.NotNil(t, provider, "Provider should not be nil")
@@ -50,16 +73,19 @@ func TestGetPowerSource(t *testing.T) {
 }
 
 func TestGetBatteryPercentage(t *testing.T) {
-	provider := NewProvider()
-	ctx := t.Context()
-
-	percentage, err := provider.GetBatteryPercentage(ctx)
-	if errors.Is(err, types.ErrNoBattery) {
-		t.Skip("No battery present, skipping test")
+	for _, tc := range batteryProviders(t) {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			percentage, err := tc.provider.GetBatteryPercentage(ctx)
+			if errors.Is(err, types.ErrNoBattery) {
+				t.Skip("No battery present, skipping test")
+			}
+			require.NoError(t, err)
+			assert.GreaterOrEqual(t, percentage, 0.0)
+			assert.LessOrEqual(t, percentage, 100.0)
+		})
 	}
-	require.NoError(t, err)
-	assert.GreaterOrEqual(t, percentage, 0.0)
-	assert.LessOrEqual(t, percentage, 100.0)
 }
 
 func TestGetBatteryState(t *testing.T) {
@@ -77,37 +103,43 @@ func TestGetBatteryState(t *testing.T) {
 }
 
 func TestGetBatteryHealth(t *testing.T) {
-	provider := NewProvider()
-	ctx := t.Context()
-
-	health, err := provider.GetBatteryHealth(ctx)
-	if errors.Is(err, types.ErrNoBattery) {
-		t.Skip("No battery present, skipping test")
+	for _, tc := range batteryProviders(t) {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			health, err := tc.provider.GetBatteryHealth(ctx)
+			if errors.Is(err, types.ErrNoBattery) {
+				t.Skip("No battery present, skipping test")
+			}
+			require.NoError(t, err)
+			assert.Contains(t, []types.BatteryHealth{
+				types.BatteryHealthGood,
+				types.BatteryHealthFair,
+				types.BatteryHealthPoor,
+				types.BatteryHealthUnknown,
+			}, health)
+		})
 	}
-	require.NoError(t, err)
-	assert.Contains(t, []types.BatteryHealth{
-		types.BatteryHealthGood,
-		types.BatteryHealthFair,
-		types.BatteryHealthPoor,
-		types.BatteryHealthUnknown,
-	}, health)
 }
 
 func TestGetTimeRemaining(t *testing.T) {
-	provider := NewProvider()
-	ctx := t.Context()
-
-	duration, err := provider.GetTimeRemaining(ctx)
-	if errors.Is(err, types.ErrNoBattery) {
-		t.Skip("No battery present, skipping test")
-	}
-	require.NoError(t, err)
-
-	// Time remaining can be negative when charging
-	if duration < 0 {
-		assert.LessOrEqual(t, duration, time.Duration(0), "charging time should be negative")
-	} else {
-		assert.GreaterOrEqual(t, duration, time.Duration(0), "discharging time should be non-negative")
+	for _, tc := range batteryProviders(t) {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			duration, err := tc.provider.GetTimeRemaining(ctx)
+			if errors.Is(err, types.ErrNoBattery) {
+				t.Skip("No battery present, skipping test")
+			}
+			require.NoError(t, err)
+
+			// Time remaining can be negative when charging
+			if duration < 0 {
+				assert.LessOrEqual(t, duration, time.Duration(0), "charging time should be negative")
+			} else {
+				assert.GreaterOrEqual(t, duration, time.Duration(0), "discharging time should be non-negative")
+			}
+		})
 	}
 }
 
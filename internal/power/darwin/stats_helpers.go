@@ -0,0 +1,89 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+import (
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// These helpers all build on getStats, whose implementation is chosen at
+// build time: impl.go (cgo/IOKit, falling back to fallback.go on error) or
+// impl_nocgo.go (fallback.go only, for -tags nocgo builds).
+
+func getPowerSource(policy HealthPolicy) (types.PowerSource, error) {
+	stats, err := getStats(policy)
+	if err != nil {
+		return types.PowerSourceUnknown, err
+	}
+	return stats.Source, nil
+}
+
+func getBatteryPercentage(policy HealthPolicy) (float64, error) {
+	stats, err := getStats(policy)
+	if err != nil {
+		return 0, err
+	}
+	if !stats.IsPresent {
+		return 0, types.ErrNoBattery
+	}
+	return stats.Percentage, nil
+}
+
+func getBatteryState(policy HealthPolicy) (types.BatteryState, error) {
+	stats, err := getStats(policy)
+	if err != nil {
+		return types.BatteryStateUnknown, err
+	}
+	return stats.State, nil
+}
+
+func getBatteryHealth(policy HealthPolicy) (types.BatteryHealth, error) {
+	stats, err := getStats(policy)
+	if err != nil {
+		return types.BatteryHealthUnknown, err
+	}
+	if !stats.IsPresent {
+		return types.BatteryHealthUnknown, types.ErrNoBattery
+	}
+	return determineBatteryHealth(stats.IsPresent, stats.CycleCount, stats.MaxCapacity, stats.DesignCapacity, policy), nil
+}
+
+// getBatteryHealthPercentage returns the raw MaxCapacity/DesignCapacity
+// ratio as a percentage, independent of the CycleCount-based classification
+// applied by getBatteryHealth.
+func getBatteryHealthPercentage(policy HealthPolicy) (float64, error) {
+	stats, err := getStats(policy)
+	if err != nil {
+		return 0, err
+	}
+	if !stats.IsPresent {
+		return 0, types.ErrNoBattery
+	}
+	percent, ok := batteryHealthPercentage(stats.MaxCapacity, stats.DesignCapacity)
+	if !ok {
+		return 0, types.ErrIOKitFailure
+	}
+	return percent, nil
+}
+
+func getTimeRemaining(policy HealthPolicy) (time.Duration, error) {
+	stats, err := getStats(policy)
+	if err != nil {
+		return 0, err
+	}
+	if !stats.IsPresent {
+		return 0, types.ErrNoBattery
+	}
+	return stats.TimeRemaining, nil
+}
+
+func getPowerConsumption(policy HealthPolicy) (float64, error) {
+	stats, err := getStats(policy)
+	if err != nil {
+		return 0, err
+	}
+	return stats.TotalPower, nil
+}
@@ -1,10 +1,9 @@
-//go:build darwin
-// +build darwin
-
 // Package power provides a platform-agnostic interface for retrieving power-related
 // information from the system. For macOS (Darwin), it uses the IOKit framework's
 // IOPowerSources API to gather basic power metrics like battery presence, charging
-// state, and capacity percentage.
+// state, and capacity percentage. For FreeBSD, it shells out to sysctl(8) and
+// acpiconf(8) instead. Other platforms get a stub provider whose methods all
+// return metrics.ErrUnsupportedPlatform.
 //
 // The package is designed with the following principles:
 // - Platform independence through clear interface boundaries
@@ -21,14 +20,3 @@
 //	}
 //	fmt.Printf("Power source: %s, Battery: %.1f%%\n", stats.Source, stats.Percentage)
 package power
-
-import (
-	"github.com/sm-moshi/dmetrics-go/api/metrics"
-	"github.com/sm-moshi/dmetrics-go/internal/power/darwin"
-)
-
-// NewProvider creates a new power metrics provider for the current platform.
-// On Darwin systems, this returns a provider that uses IOKit for power metrics.
-func NewProvider() metrics.PowerMetrics {
-	return darwin.NewProvider()
-}
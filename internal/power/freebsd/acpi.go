@@ -0,0 +1,166 @@
+//go:build freebsd
+// +build freebsd
+
+package freebsd
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// This file implements a pure-Go battery reader that shells out to
+// sysctl(8) and acpiconf(8) instead of linking against any ACPI library,
+// mirroring internal/power/darwin/fallback.go's pmset/ioreg parser.
+
+var (
+	// acpiDesignCapacityRe matches `Design capacity:       5000 mAh`.
+	acpiDesignCapacityRe = regexp.MustCompile(`Design capacity:\s*(\d+) mAh`)
+
+	// acpiLastFullCapacityRe matches `Last full capacity:    4500 mAh`.
+	acpiLastFullCapacityRe = regexp.MustCompile(`Last full capacity:\s*(\d+) mAh`)
+
+	// acpiDesignVoltageRe matches `Design voltage:         11100 mV`.
+	acpiDesignVoltageRe = regexp.MustCompile(`Design voltage:\s*(\d+) mV`)
+
+	// acpiStateRe matches `State:                  discharging`.
+	acpiStateRe = regexp.MustCompile(`State:\s*(\S+)`)
+
+	// acpiRemainingCapacityRe matches `Remaining capacity:     82%`.
+	acpiRemainingCapacityRe = regexp.MustCompile(`Remaining capacity:\s*(\d+)%`)
+
+	// acpiRemainingTimeRe matches `Remaining time:         3:45`, or
+	// "unknown" when acpiconf hasn't estimated it yet.
+	acpiRemainingTimeRe = regexp.MustCompile(`Remaining time:\s*(\d+):(\d+)`)
+
+	// acpiPresentRateRe matches `Present rate:           1500 mW`.
+	acpiPresentRateRe = regexp.MustCompile(`Present rate:\s*(\d+) mW`)
+)
+
+// errNoACPIBattery indicates acpiconf reported no battery in the
+// requested unit, which we treat identically to types.ErrNoBattery.
+var errNoACPIBattery = errors.New("freebsd: no ACPI battery unit 0")
+
+// acpiBatteryStats is the subset of `acpiconf -i 0` output getStats needs.
+type acpiBatteryStats struct {
+	designCapacityMAh int
+	maxCapacityMAh    int
+	designVoltageMV   int
+	state             types.BatteryState
+	percentage        float64
+	remaining         time.Duration
+	presentRateMW     int
+}
+
+// sysctlOutput runs `sysctl -n name` and returns its trimmed stdout.
+// internal/cpu/freebsd has an identical helper, but the two packages don't
+// share an import path, so each keeps its own copy rather than pulling in
+// a shared internal package for one function.
+func sysctlOutput(name string) (string, error) {
+	out, err := exec.Command("sysctl", "-n", name).Output()
+	if err != nil {
+		return "", fmt.Errorf("sysctl -n %s: %w", name, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// getACPILine reports whether hw.acpi.acline is present and "1" (on AC
+// power). FreeBSD reports this as 1 on AC, 0 on battery; systems with no
+// ACPI support at all fail the sysctl read.
+func getACLine() (bool, error) {
+	out, err := sysctlOutput("hw.acpi.acline")
+	if err != nil {
+		return false, err
+	}
+	return out == "1", nil
+}
+
+// runACPIConf runs `acpiconf -i 0` and returns its stdout, or
+// errNoACPIBattery if the unit doesn't exist (e.g. a desktop with no
+// battery).
+func runACPIConf() (string, error) {
+	out, err := exec.Command("acpiconf", "-i", "0").Output()
+	if err != nil {
+		return "", errNoACPIBattery
+	}
+	return string(out), nil
+}
+
+// parseACPIConfOutput extracts battery state, capacity, and rate fields
+// from `acpiconf -i 0` output, e.g.:
+//
+//	Design capacity:       5000 mAh
+//	Last full capacity:    4500 mAh
+//	Design voltage:        11100 mV
+//	State:                 discharging
+//	Remaining capacity:    82%
+//	Remaining time:        3:45
+//	Present rate:          1500 mW
+func parseACPIConfOutput(output string) acpiBatteryStats {
+	var s acpiBatteryStats
+
+	if m := acpiDesignCapacityRe.FindStringSubmatch(output); m != nil {
+		s.designCapacityMAh, _ = strconv.Atoi(m[1])
+	}
+	if m := acpiLastFullCapacityRe.FindStringSubmatch(output); m != nil {
+		s.maxCapacityMAh, _ = strconv.Atoi(m[1])
+	}
+	if m := acpiDesignVoltageRe.FindStringSubmatch(output); m != nil {
+		s.designVoltageMV, _ = strconv.Atoi(m[1])
+	}
+	if m := acpiRemainingCapacityRe.FindStringSubmatch(output); m != nil {
+		s.percentage, _ = strconv.ParseFloat(m[1], 64)
+	}
+	if m := acpiPresentRateRe.FindStringSubmatch(output); m != nil {
+		s.presentRateMW, _ = strconv.Atoi(m[1])
+	}
+
+	s.state = mapACPIState(output)
+
+	if m := acpiRemainingTimeRe.FindStringSubmatch(output); m != nil {
+		hours, _ := strconv.Atoi(m[1])
+		minutes, _ := strconv.Atoi(m[2])
+		s.remaining = time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute
+	}
+
+	return s
+}
+
+// mapACPIState maps acpiconf's "State:" value to a types.BatteryState.
+func mapACPIState(output string) types.BatteryState {
+	m := acpiStateRe.FindStringSubmatch(output)
+	if m == nil {
+		return types.BatteryStateUnknown
+	}
+
+	switch strings.ToLower(m[1]) {
+	case "charging":
+		return types.BatteryStateCharging
+	case "discharging":
+		return types.BatteryStateDischarging
+	case "high", "full":
+		return types.BatteryStateFull
+	case "low", "critical":
+		return types.BatteryStateDischarging
+	case "not", "not-charging":
+		return types.BatteryStateNotCharging
+	default:
+		return types.BatteryStateUnknown
+	}
+}
+
+// capacityWattHours converts a mAh capacity reading to Watt-hours using
+// the battery's design voltage, matching the units types.PowerStats
+// documents for DesignCapacity/MaxCapacity/CurrentCapacity.
+func capacityWattHours(mAh, designMV int) float64 {
+	if designMV <= 0 {
+		return 0
+	}
+	return float64(mAh) * float64(designMV) / 1_000_000.0
+}
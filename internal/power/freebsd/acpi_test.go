@@ -0,0 +1,69 @@
+//go:build freebsd
+// +build freebsd
+
+package freebsd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+const sampleACPIConfDischarging = `Battery 0:
+Design capacity:       5000 mAh
+Last full capacity:    4500 mAh
+Design voltage:        11100 mV
+State:                 discharging
+Remaining capacity:    82%
+Remaining time:        3:45
+Present rate:          1500 mW
+`
+
+const sampleACPIConfCharging = `Battery 0:
+State:                 charging
+Remaining capacity:    55%
+Remaining time:        unknown
+`
+
+const sampleACPIConfFull = `Battery 0:
+State:                 high
+Remaining capacity:    100%
+`
+
+func TestParseACPIConfOutputDischarging(t *testing.T) {
+	s := parseACPIConfOutput(sampleACPIConfDischarging)
+	assert.Equal(t, 5000, s.designCapacityMAh)
+	assert.Equal(t, 4500, s.maxCapacityMAh)
+	assert.Equal(t, 11100, s.designVoltageMV)
+	assert.Equal(t, types.BatteryStateDischarging, s.state)
+	assert.Equal(t, 82.0, s.percentage)
+	assert.Equal(t, 3*time.Hour+45*time.Minute, s.remaining)
+	assert.Equal(t, 1500, s.presentRateMW)
+}
+
+func TestParseACPIConfOutputCharging(t *testing.T) {
+	s := parseACPIConfOutput(sampleACPIConfCharging)
+	assert.Equal(t, types.BatteryStateCharging, s.state)
+	assert.Equal(t, 55.0, s.percentage)
+	assert.Equal(t, time.Duration(0), s.remaining, "unknown remaining time should not match the H:MM regex")
+}
+
+func TestParseACPIConfOutputFull(t *testing.T) {
+	s := parseACPIConfOutput(sampleACPIConfFull)
+	assert.Equal(t, types.BatteryStateFull, s.state)
+}
+
+func TestMapACPIState(t *testing.T) {
+	assert.Equal(t, types.BatteryStateCharging, mapACPIState("State: charging"))
+	assert.Equal(t, types.BatteryStateDischarging, mapACPIState("State: discharging"))
+	assert.Equal(t, types.BatteryStateFull, mapACPIState("State: high"))
+	assert.Equal(t, types.BatteryStateUnknown, mapACPIState("unparseable"))
+}
+
+func TestCapacityWattHours(t *testing.T) {
+	assert.InDelta(t, 55.5, capacityWattHours(5000, 11100), 0.01)
+	assert.Equal(t, 0.0, capacityWattHours(5000, 0))
+}
@@ -0,0 +1,9 @@
+//go:build freebsd
+// +build freebsd
+
+// Package freebsd provides FreeBSD-specific power metrics implementation.
+// Like internal/cpu/freebsd, it has no cgo dependency: AC/battery source
+// comes from the hw.acpi.acline sysctl and battery detail comes from
+// parsing `acpiconf -i 0` output, the same cgo-free, shell-out-to-a-CLI-
+// tool approach internal/power/darwin/fallback.go uses for pmset/ioreg.
+package freebsd
@@ -0,0 +1,233 @@
+//go:build freebsd
+// +build freebsd
+
+package freebsd
+
+import (
+	"context"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// Provider implements the power metrics collection for FreeBSD systems by
+// shelling out to sysctl(8) and acpiconf(8). See doc.go for why this
+// package avoids cgo.
+type Provider struct{}
+
+// NewProvider creates a new FreeBSD power metrics provider.
+func NewProvider() *Provider {
+	return &Provider{}
+}
+
+// getStats collects power and battery statistics from hw.acpi.acline and
+// `acpiconf -i 0`, mirroring internal/power/darwin/fallback.go's
+// fallbackGetStats.
+func getStats() (*types.PowerStats, error) {
+	onAC, err := getACLine()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &types.PowerStats{
+		Source:    types.PowerSourceBattery,
+		Timestamp: time.Now(),
+	}
+	if onAC {
+		stats.Source = types.PowerSourceAC
+	}
+
+	acpiOut, err := runACPIConf()
+	if err != nil {
+		stats.Health = types.BatteryHealthUnknown
+		return stats, nil
+	}
+
+	info := parseACPIConfOutput(acpiOut)
+	stats.IsPresent = true
+	stats.State = info.state
+	stats.Percentage = info.percentage
+	stats.TimeRemaining = info.remaining
+	stats.DesignCapacity = capacityWattHours(info.designCapacityMAh, info.designVoltageMV)
+	stats.MaxCapacity = capacityWattHours(info.maxCapacityMAh, info.designVoltageMV)
+	stats.CurrentCapacity = stats.MaxCapacity * info.percentage / 100
+	stats.TotalPower = float64(info.presentRateMW) / 1000
+	stats.Health = determineBatteryHealth(stats.MaxCapacity, stats.DesignCapacity)
+
+	return stats, nil
+}
+
+// GetStats returns current power and battery statistics.
+func (p *Provider) GetStats(ctx context.Context) (*types.PowerStats, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return getStats()
+}
+
+// GetPowerSource returns the current power source.
+func (p *Provider) GetPowerSource(ctx context.Context) (types.PowerSource, error) {
+	if err := ctx.Err(); err != nil {
+		return types.PowerSourceUnknown, err
+	}
+	stats, err := getStats()
+	if err != nil {
+		return types.PowerSourceUnknown, err
+	}
+	return stats.Source, nil
+}
+
+// GetBatteryPercentage returns the current battery charge percentage.
+func (p *Provider) GetBatteryPercentage(ctx context.Context) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	stats, err := getStats()
+	if err != nil {
+		return 0, err
+	}
+	if !stats.IsPresent {
+		return 0, types.ErrNoBattery
+	}
+	return stats.Percentage, nil
+}
+
+// GetBatteryPresent returns whether a battery is present in the system.
+func (p *Provider) GetBatteryPresent(context.Context) (bool, error) {
+	stats, err := getStats()
+	if err != nil {
+		return false, err
+	}
+	return stats.IsPresent, nil
+}
+
+// GetBatteryState returns the current battery charging state.
+func (p *Provider) GetBatteryState(ctx context.Context) (types.BatteryState, error) {
+	if err := ctx.Err(); err != nil {
+		return types.BatteryStateUnknown, err
+	}
+	stats, err := getStats()
+	if err != nil {
+		return types.BatteryStateUnknown, err
+	}
+	return stats.State, nil
+}
+
+// GetBatteryHealth returns the current battery health status.
+func (p *Provider) GetBatteryHealth(ctx context.Context) (types.BatteryHealth, error) {
+	if err := ctx.Err(); err != nil {
+		return types.BatteryHealthUnknown, err
+	}
+	stats, err := getStats()
+	if err != nil {
+		return types.BatteryHealthUnknown, err
+	}
+	if !stats.IsPresent {
+		return types.BatteryHealthUnknown, types.ErrNoBattery
+	}
+	return stats.Health, nil
+}
+
+// GetBatteryHealthPercentage returns the raw MaxCapacity/DesignCapacity
+// ratio as a percentage (0-100).
+func (p *Provider) GetBatteryHealthPercentage(ctx context.Context) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	stats, err := getStats()
+	if err != nil {
+		return 0, err
+	}
+	if !stats.IsPresent || stats.DesignCapacity <= 0 {
+		return 0, types.ErrNoBattery
+	}
+	return stats.MaxCapacity / stats.DesignCapacity * 100, nil
+}
+
+// GetTimeRemaining returns the estimated time remaining on battery power.
+func (p *Provider) GetTimeRemaining(ctx context.Context) (time.Duration, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	stats, err := getStats()
+	if err != nil {
+		return 0, err
+	}
+	if !stats.IsPresent {
+		return 0, types.ErrNoBattery
+	}
+	return stats.TimeRemaining, nil
+}
+
+// GetPowerConsumption returns the current system power consumption.
+func (p *Provider) GetPowerConsumption(ctx context.Context) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	stats, err := getStats()
+	if err != nil {
+		return 0, err
+	}
+	return stats.TotalPower, nil
+}
+
+// Watch monitors power metrics and sends updates through the returned
+// channel, mirroring internal/power/darwin.Provider.Watch.
+func (p *Provider) Watch(ctx context.Context, interval time.Duration) (<-chan *types.PowerStats, error) {
+	if interval <= 0 {
+		return nil, types.ErrInvalidInterval
+	}
+
+	ch := make(chan *types.PowerStats)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stats, err := p.GetStats(ctx)
+				if err != nil {
+					return
+				}
+				select {
+				case ch <- stats:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Shutdown cleans up resources used by the provider; a no-op since the
+// provider holds no long-lived handles.
+func (p *Provider) Shutdown() error {
+	return nil
+}
+
+// determineBatteryHealth classifies battery health from the
+// MaxCapacity/DesignCapacity ratio. Unlike Darwin, this package doesn't
+// expose a configurable HealthPolicy: acpiconf gives no cycle-count field
+// to combine with the capacity ratio, so there's only one axis to
+// classify, and the replay package's precedent (see
+// pkg/metrics/replay/battery_curve.go) is to duplicate a fixed threshold
+// rather than add API surface for a single number.
+func determineBatteryHealth(maxCapacity, designCapacity float64) types.BatteryHealth {
+	if maxCapacity <= 0 || designCapacity <= 0 {
+		return types.BatteryHealthUnknown
+	}
+
+	ratio := maxCapacity / designCapacity * 100
+	switch {
+	case ratio < 80:
+		return types.BatteryHealthPoor
+	case ratio < 90:
+		return types.BatteryHealthFair
+	default:
+		return types.BatteryHealthGood
+	}
+}
@@ -0,0 +1,33 @@
+//go:build darwin
+// +build darwin
+
+package power
+
+import (
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/internal/power/darwin"
+)
+
+// NewProvider creates a new power metrics provider for the current platform.
+// On Darwin systems, this returns a provider that uses IOKit for power metrics.
+func NewProvider() metrics.PowerMetrics {
+	return darwin.NewProvider()
+}
+
+// HealthPolicy controls the charge-cycle and capacity-ratio thresholds used
+// to classify battery health. See darwin.HealthPolicy for field details.
+type HealthPolicy = darwin.HealthPolicy
+
+// DefaultHealthPolicy returns the thresholds used when a provider is
+// created with NewProvider.
+func DefaultHealthPolicy() HealthPolicy {
+	return darwin.DefaultHealthPolicy()
+}
+
+// NewProviderWithPolicy creates a new power metrics provider for the
+// current platform that classifies battery health according to policy,
+// so callers can match vendor/MDM conventions (e.g. Apple's own "Service
+// Recommended" trigger) without forking this package.
+func NewProviderWithPolicy(policy HealthPolicy) metrics.PowerMetrics {
+	return darwin.NewProviderWithPolicy(policy)
+}
@@ -0,0 +1,16 @@
+//go:build freebsd
+// +build freebsd
+
+package power
+
+import (
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/internal/power/freebsd"
+)
+
+// NewProvider creates a new power metrics provider for the current platform.
+// On FreeBSD systems, this returns a provider that reads the hw.acpi.acline
+// sysctl and acpiconf(8) output for power metrics.
+func NewProvider() metrics.PowerMetrics {
+	return freebsd.NewProvider()
+}
@@ -0,0 +1,16 @@
+//go:build !darwin && !freebsd
+// +build !darwin,!freebsd
+
+package power
+
+import (
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/internal/power/stub"
+)
+
+// NewProvider creates a new power metrics provider for the current platform.
+// This platform has no native power metrics backend, so every method on the
+// returned provider returns metrics.ErrUnsupportedPlatform.
+func NewProvider() metrics.PowerMetrics {
+	return stub.NewProvider()
+}
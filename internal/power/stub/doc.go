@@ -0,0 +1,8 @@
+//go:build !darwin && !freebsd
+// +build !darwin,!freebsd
+
+// Package stub provides a power metrics provider for platforms this module
+// has no native implementation for. Every method returns
+// metrics.ErrUnsupportedPlatform so callers on an unsupported platform fail
+// fast with a clear error instead of a build failure.
+package stub
@@ -0,0 +1,61 @@
+//go:build !darwin && !freebsd
+// +build !darwin,!freebsd
+
+package stub
+
+import (
+	"context"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// Provider implements metrics.PowerMetrics for platforms without a native
+// power metrics backend. Every method returns metrics.ErrUnsupportedPlatform.
+type Provider struct{}
+
+// NewProvider creates a new stub power metrics provider.
+func NewProvider() *Provider {
+	return &Provider{}
+}
+
+// GetStats always returns ErrUnsupportedPlatform.
+func (p *Provider) GetStats(context.Context) (*types.PowerStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// GetPowerSource always returns ErrUnsupportedPlatform.
+func (p *Provider) GetPowerSource(context.Context) (types.PowerSource, error) {
+	return types.PowerSourceUnknown, metrics.ErrUnsupportedPlatform
+}
+
+// GetBatteryPercentage always returns ErrUnsupportedPlatform.
+func (p *Provider) GetBatteryPercentage(context.Context) (float64, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetBatteryPresent always returns ErrUnsupportedPlatform.
+func (p *Provider) GetBatteryPresent(context.Context) (bool, error) {
+	return false, metrics.ErrUnsupportedPlatform
+}
+
+// GetBatteryHealth always returns ErrUnsupportedPlatform.
+func (p *Provider) GetBatteryHealth(context.Context) (types.BatteryHealth, error) {
+	return types.BatteryHealthUnknown, metrics.ErrUnsupportedPlatform
+}
+
+// GetBatteryHealthPercentage always returns ErrUnsupportedPlatform.
+func (p *Provider) GetBatteryHealthPercentage(context.Context) (float64, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// Watch always returns ErrUnsupportedPlatform.
+func (p *Provider) Watch(context.Context, time.Duration) (<-chan *types.PowerStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// Shutdown is a no-op; the provider holds no resources.
+func (p *Provider) Shutdown() error {
+	return nil
+}
@@ -0,0 +1,89 @@
+package check
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Metric is a single labelled value contributing to a Result, along with
+// the thresholds it was evaluated against and the unit/min/max used to
+// render its perfdata field.
+type Metric struct {
+	Label string
+	Value float64
+	Unit  string
+	Warn  Threshold
+	Crit  Threshold
+	Min   string
+	Max   string
+}
+
+// Result is the outcome of evaluating one or more Metrics, ready to be
+// printed as Nagios plugin output via String and used as the process exit
+// code via Status.ExitCode.
+type Result struct {
+	Status  Status
+	Message string
+	Metrics []Metric
+}
+
+// Evaluate checks a single named value against warn/crit thresholds and
+// returns a Result with a one-line summary message and matching perfdata.
+func Evaluate(label string, value float64, unit string, warn, crit Threshold) Result {
+	return EvaluateMetric(Metric{Label: label, Value: value, Unit: unit, Warn: warn, Crit: crit})
+}
+
+// EvaluateMetric checks a single Metric against its thresholds.
+func EvaluateMetric(m Metric) Result {
+	status := StatusOK
+	switch {
+	case m.Crit.Breaches(m.Value):
+		status = StatusCritical
+	case m.Warn.Breaches(m.Value):
+		status = StatusWarning
+	}
+
+	return Result{
+		Status:  status,
+		Message: fmt.Sprintf("%s is %g%s", m.Label, m.Value, m.Unit),
+		Metrics: []Metric{m},
+	}
+}
+
+// Merge combines results into a single Result whose Status is the worst of
+// all inputs, whose Message joins each input's Message with "; ", and whose
+// Metrics is the concatenation of all inputs' Metrics (for perfdata).
+func Merge(results ...Result) Result {
+	merged := Result{Status: StatusOK}
+	messages := make([]string, 0, len(results))
+	for _, r := range results {
+		merged.Status = worse(merged.Status, r.Status)
+		messages = append(messages, r.Message)
+		merged.Metrics = append(merged.Metrics, r.Metrics...)
+	}
+	merged.Message = strings.Join(messages, "; ")
+	return merged
+}
+
+// String renders r as Nagios plugin output:
+// "STATUS - message | perfdata".
+func (r Result) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s - %s", r.Status, r.Message)
+	if len(r.Metrics) > 0 {
+		b.WriteString(" | ")
+		for i, m := range r.Metrics {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(m.perfdata())
+		}
+	}
+	return b.String()
+}
+
+// perfdata renders m as a single Nagios perfdata field:
+// "label=value[unit];warn;crit;min;max".
+func (m Metric) perfdata() string {
+	return fmt.Sprintf("%s=%g%s;%s;%s;%s;%s", m.Label, m.Value, m.Unit, m.Warn, m.Crit, m.Min, m.Max)
+}
@@ -0,0 +1,19 @@
+// Package check evaluates dmetrics readings against Nagios-style
+// warning/critical thresholds and formats the result as Nagios plugin
+// output, so the module can be driven from Icinga2, check_mk, or any other
+// monitoring system that speaks the Nagios plugin API.
+//
+// Threshold strings follow the Nagios plugin development guidelines range
+// syntax: "10" means alert outside [0,10], "10:20" means alert outside
+// [10,20], "~:20" means alert above 20, "10:" means alert below 10, and a
+// leading "@" inverts the range to alert *inside* it instead. Parse a
+// threshold with ParseThreshold and evaluate a reading with Threshold.Status.
+//
+// Example usage:
+//
+//	warn, _ := check.ParseThreshold("80")
+//	crit, _ := check.ParseThreshold("95")
+//	result := check.Evaluate("cpu_usage", 92, "%", warn, crit)
+//	fmt.Println(result.String()) // "WARNING - cpu_usage is 92% | cpu_usage=92%;80;95;;"
+//	os.Exit(result.Status.ExitCode())
+package check
@@ -0,0 +1,69 @@
+package check
+
+// Status is a Nagios plugin return status.
+type Status int
+
+const (
+	// StatusOK indicates the checked value is within normal bounds.
+	StatusOK Status = iota
+	// StatusWarning indicates the checked value breached the warning threshold.
+	StatusWarning
+	// StatusCritical indicates the checked value breached the critical threshold.
+	StatusCritical
+	// StatusUnknown indicates the check could not be evaluated, e.g. because
+	// the underlying metric could not be collected.
+	StatusUnknown
+)
+
+// String returns the Nagios plugin output label for s.
+func (s Status) String() string {
+	switch s {
+	case StatusOK:
+		return "OK"
+	case StatusWarning:
+		return "WARNING"
+	case StatusCritical:
+		return "CRITICAL"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ExitCode returns the Nagios plugin exit code for s (0/1/2/3).
+func (s Status) ExitCode() int {
+	switch s {
+	case StatusOK:
+		return 0
+	case StatusWarning:
+		return 1
+	case StatusCritical:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// worse returns the more severe of a and b, where severity increases
+// OK < WARNING < CRITICAL, and UNKNOWN is treated as more severe than
+// everything except CRITICAL so a failed collection isn't masked by an
+// otherwise-healthy reading.
+func worse(a, b Status) Status {
+	rank := func(s Status) int {
+		switch s {
+		case StatusOK:
+			return 0
+		case StatusWarning:
+			return 1
+		case StatusUnknown:
+			return 2
+		case StatusCritical:
+			return 3
+		default:
+			return 2
+		}
+	}
+	if rank(b) > rank(a) {
+		return b
+	}
+	return a
+}
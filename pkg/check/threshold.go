@@ -0,0 +1,95 @@
+package check
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidThreshold is returned when a threshold string doesn't match the
+// Nagios plugin development guidelines range syntax.
+var ErrInvalidThreshold = errors.New("invalid threshold range")
+
+// Threshold is a Nagios-style alerting range, as produced by ParseThreshold.
+// A value breaches the threshold when it falls outside [Start, End], unless
+// Inverted is set, in which case it breaches when the value falls inside
+// [Start, End].
+type Threshold struct {
+	raw      string
+	Start    float64
+	End      float64
+	Inverted bool
+}
+
+// ParseThreshold parses a Nagios plugin range specification, e.g. "10",
+// "10:20", "~:20", "10:", or "@10:20". An empty string is a valid threshold
+// that never breaches, so callers can leave warn/crit unset.
+func ParseThreshold(s string) (Threshold, error) {
+	if s == "" {
+		return Threshold{raw: s, Start: math.Inf(-1), End: math.Inf(1)}, nil
+	}
+
+	t := Threshold{raw: s, Start: 0, End: math.Inf(1)}
+
+	if strings.HasPrefix(s, "@") {
+		t.Inverted = true
+		s = s[1:]
+	}
+
+	start, end, hasRange := strings.Cut(s, ":")
+	if !hasRange {
+		end = start
+		start = "0"
+	}
+
+	var err error
+	if t.Start, err = parseRangeBound(start, math.Inf(-1)); err != nil {
+		return Threshold{}, fmt.Errorf("%w: %q: %w", ErrInvalidThreshold, s, err)
+	}
+	if end == "" {
+		t.End = math.Inf(1)
+	} else if t.End, err = parseRangeBound(end, math.Inf(1)); err != nil {
+		return Threshold{}, fmt.Errorf("%w: %q: %w", ErrInvalidThreshold, s, err)
+	}
+
+	if t.Start > t.End {
+		return Threshold{}, fmt.Errorf("%w: %q: start %g greater than end %g", ErrInvalidThreshold, s, t.Start, t.End)
+	}
+
+	return t, nil
+}
+
+// parseRangeBound parses one side of a range, treating "~" as the given
+// infinity.
+func parseRangeBound(s string, infinity float64) (float64, error) {
+	if s == "~" {
+		return infinity, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// IsZero reports whether t is the zero-value/empty threshold, i.e. it was
+// never configured and should not be evaluated.
+func (t Threshold) IsZero() bool {
+	return t.raw == "" && t.Start == 0 && math.IsInf(t.End, 1) && !t.Inverted
+}
+
+// Breaches reports whether value breaches the threshold.
+func (t Threshold) Breaches(value float64) bool {
+	if t.IsZero() {
+		return false
+	}
+	inside := value >= t.Start && value <= t.End
+	if t.Inverted {
+		return inside
+	}
+	return !inside
+}
+
+// String returns the original range specification, or "" for an unset
+// threshold, matching how Nagios perfdata omits absent thresholds.
+func (t Threshold) String() string {
+	return t.raw
+}
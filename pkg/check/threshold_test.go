@@ -0,0 +1,90 @@
+package check
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseThreshold(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		start float64
+		end   float64
+		inv   bool
+	}{
+		{"bare number", "10", 0, 10, false},
+		{"explicit range", "10:20", 10, 20, false},
+		{"open upper", "10:", 10, math.Inf(1), false},
+		{"open lower", "~:20", math.Inf(-1), 20, false},
+		{"inverted", "@10:20", 10, 20, true},
+		{"empty", "", math.Inf(-1), math.Inf(1), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			th, err := ParseThreshold(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.start, th.Start)
+			assert.Equal(t, tt.end, th.End)
+			assert.Equal(t, tt.inv, th.Inverted)
+		})
+	}
+}
+
+func TestParseThresholdInvalid(t *testing.T) {
+	_, err := ParseThreshold("20:10")
+	assert.ErrorIs(t, err, ErrInvalidThreshold, "start greater than end should be rejected")
+
+	_, err = ParseThreshold("not-a-number")
+	assert.ErrorIs(t, err, ErrInvalidThreshold)
+}
+
+func TestThresholdBreaches(t *testing.T) {
+	th, err := ParseThreshold("80:95")
+	require.NoError(t, err)
+
+	assert.False(t, th.Breaches(85), "85 is within 80:95")
+	assert.True(t, th.Breaches(96), "96 is above 95")
+	assert.True(t, th.Breaches(79), "79 is below 80")
+
+	inverted, err := ParseThreshold("@80:95")
+	require.NoError(t, err)
+	assert.True(t, inverted.Breaches(85), "inverted range breaches when inside")
+	assert.False(t, inverted.Breaches(96))
+}
+
+func TestEvaluate(t *testing.T) {
+	// Bare numbers, not ranges: "80" means alert outside [0,80] (i.e. above
+	// 80), "95" means alert outside [0,95] (i.e. above 95). That's the
+	// idiomatic Nagios way to express a monotonic warn/crit pair; "80:95"
+	// and "95:" each describe a closed/open *band*, not a rising floor, and
+	// don't compose the way a warn-then-crit escalation needs.
+	warn, err := ParseThreshold("80")
+	require.NoError(t, err)
+	crit, err := ParseThreshold("95")
+	require.NoError(t, err)
+
+	result := Evaluate("cpu_usage", 92, "%", warn, crit)
+	assert.Equal(t, StatusWarning, result.Status)
+
+	result = Evaluate("cpu_usage", 50, "%", warn, crit)
+	assert.Equal(t, StatusOK, result.Status)
+
+	result = Evaluate("cpu_usage", 97, "%", warn, crit)
+	assert.Equal(t, StatusCritical, result.Status)
+}
+
+func TestMergeTakesWorstStatus(t *testing.T) {
+	ok := Evaluate("a", 1, "", Threshold{}, Threshold{})
+	warn, err := ParseThreshold("0:0")
+	require.NoError(t, err)
+	warning := Evaluate("b", 1, "", warn, Threshold{})
+
+	merged := Merge(ok, warning)
+	assert.Equal(t, StatusWarning, merged.Status)
+	assert.Len(t, merged.Metrics, 2)
+}
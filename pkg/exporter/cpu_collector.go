@@ -0,0 +1,68 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// cpuCollector collects dmetrics_cpu_* and dmetrics_loadavg metrics from a
+// CPUMetrics provider.
+type cpuCollector struct {
+	cpu metrics.CPUMetrics
+}
+
+// NewCPUCollector creates a Collector that reports CPU usage, frequency,
+// per-core usage, and load average from provider.
+func NewCPUCollector(provider metrics.CPUMetrics) Collector {
+	return &cpuCollector{cpu: provider}
+}
+
+func (c *cpuCollector) Collect(ctx context.Context, w io.Writer) error {
+	stats, err := c.cpu.GetStats(ctx)
+	if err != nil {
+		return fmt.Errorf("collect cpu stats: %w", err)
+	}
+
+	writeHelpType(w, "dmetrics_cpu_usage_percent", "Per-core CPU usage percentage, normalised to [0,100].", "gauge")
+	for i, usage := range stats.CoreUsage {
+		fmt.Fprintf(w, "dmetrics_cpu_usage_percent{core=\"%d\",type=\"%s\"} %g\n", i, coreType(stats, i), usage)
+	}
+
+	writeHelpType(w, "dmetrics_cpu_frequency_mhz", "Current CPU frequency in MHz.", "gauge")
+	fmt.Fprintf(w, "dmetrics_cpu_frequency_mhz %d\n", stats.FrequencyMHz)
+
+	writeHelpType(w, "dmetrics_loadavg", "System load average.", "gauge")
+	windows := [3]string{"1m", "5m", "15m"}
+	for i, window := range windows {
+		fmt.Fprintf(w, "dmetrics_loadavg{window=\"%s\"} %g\n", window, stats.LoadAvg[i])
+	}
+
+	writeHelpType(w, "dmetrics_cpu_mode_percent", "Normalised CPU time percentage, by mode.", "gauge")
+	for mode, pct := range map[string]float64{
+		"user":   stats.User,
+		"system": stats.System,
+		"idle":   stats.Idle,
+		"nice":   stats.Nice,
+	} {
+		fmt.Fprintf(w, "dmetrics_cpu_mode_percent{mode=\"%s\"} %g\n", mode, pct)
+	}
+
+	return nil
+}
+
+// coreType reports whether core i is a performance or efficiency core,
+// assuming performance cores are enumerated before efficiency cores as the
+// rest of the module does (see examples/cpu/cpu_stats.go).
+func coreType(stats *types.CPUStats, i int) string {
+	if i < stats.PerformanceCores {
+		return "P"
+	}
+	if stats.EfficiencyCores > 0 {
+		return "E"
+	}
+	return "unknown"
+}
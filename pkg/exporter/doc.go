@@ -0,0 +1,31 @@
+// Package exporter turns one or more metric providers into a Prometheus/
+// OpenMetrics-compatible scrape target. Unlike pkg/exporter/prometheus,
+// which hard-codes CPU and power collection, this package is built around a
+// pluggable Collector interface so downstream users can register their own
+// metric sources (containers, GPUs, custom sensors) alongside the ones this
+// module ships.
+//
+// A request's Accept header picks the exposition format: "application/
+// openmetrics-text" serves OpenMetrics, anything else falls back to
+// Prometheus text exposition format. There is no JSON encoding path: since
+// a Collector writes pre-formatted text straight to the response body
+// rather than sending structured samples, encoding to a third format would
+// mean collectors reporting structured values instead, which is a bigger
+// redesign than this package's scope calls for.
+//
+// Example usage:
+//
+//	cpuProvider := cpu.NewProvider()
+//	powerProvider := power.NewProvider()
+//	defer cpuProvider.Shutdown()
+//	defer powerProvider.Shutdown()
+//
+//	exp := exporter.New(
+//	    exporter.NewCPUCollector(cpuProvider),
+//	    exporter.NewPowerCollector(powerProvider),
+//	)
+//	http.Handle("/metrics", exp)
+//	log.Fatal(http.ListenAndServe(":9100", nil))
+//
+// See cmd/dmetrics-exporter for a ready-to-run agent built on this package.
+package exporter
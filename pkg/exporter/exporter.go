@@ -0,0 +1,80 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Exposition format content types this Exporter negotiates between,
+// matching the values Prometheus' scrape client and client_golang use.
+const (
+	contentType            = "text/plain; version=0.0.4; charset=utf-8"
+	openMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// Collector writes the current value of the metrics it owns to w in
+// Prometheus text exposition format, including the HELP/TYPE comment lines.
+// Implementations should collect a fresh sample on every call rather than
+// caching, mirroring how the rest of this module's providers work.
+type Collector interface {
+	// Collect writes this Collector's metrics to w. A returned error is
+	// surfaced to the scraper as a comment line rather than aborting the
+	// whole scrape, so one failing Collector doesn't hide the others.
+	Collect(ctx context.Context, w io.Writer) error
+}
+
+// Exporter serves metrics from a fixed set of Collectors as a single
+// Prometheus/OpenMetrics scrape target. An Exporter is safe for concurrent
+// use by multiple goroutines.
+type Exporter struct {
+	collectors []Collector
+}
+
+// New creates an Exporter that scrapes the given collectors, in order, on
+// every request.
+func New(collectors ...Collector) *Exporter {
+	return &Exporter{collectors: collectors}
+}
+
+// ServeHTTP implements http.Handler. It runs every registered Collector in
+// turn and writes the concatenated result. The request's Accept header
+// picks the exposition format: OpenMetrics for
+// "application/openmetrics-text", Prometheus text exposition format
+// otherwise (OpenMetrics text is identical to Prometheus text exposition
+// except for a trailing "# EOF" marker, so both share the same Collect
+// output). If the request context is already cancelled, it returns without
+// writing a body.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := ctx.Err(); err != nil {
+		http.Error(w, "request cancelled", http.StatusServiceUnavailable)
+		return
+	}
+
+	openMetrics := strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text")
+	if openMetrics {
+		w.Header().Set("Content-Type", openMetricsContentType)
+	} else {
+		w.Header().Set("Content-Type", contentType)
+	}
+
+	for _, c := range e.collectors {
+		if err := c.Collect(ctx, w); err != nil {
+			fmt.Fprintf(w, "# collector error: %v\n", err)
+		}
+	}
+
+	if openMetrics {
+		fmt.Fprint(w, "# EOF\n")
+	}
+}
+
+// writeHelpType writes the HELP and TYPE comment lines Prometheus expects
+// to precede the first sample of a metric family.
+func writeHelpType(w io.Writer, name, help, metricType string) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, metricType)
+}
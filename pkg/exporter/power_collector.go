@@ -0,0 +1,54 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+)
+
+// powerCollector collects dmetrics_power_watts and dmetrics_battery_*
+// metrics from a PowerMetrics provider.
+type powerCollector struct {
+	power metrics.PowerMetrics
+}
+
+// NewPowerCollector creates a Collector that reports system power draw and
+// battery statistics from provider.
+func NewPowerCollector(provider metrics.PowerMetrics) Collector {
+	return &powerCollector{power: provider}
+}
+
+func (c *powerCollector) Collect(ctx context.Context, w io.Writer) error {
+	stats, err := c.power.GetStats(ctx)
+	if err != nil {
+		return fmt.Errorf("collect power stats: %w", err)
+	}
+
+	writeHelpType(w, "dmetrics_power_watts", "System power draw, by component.", "gauge")
+	fmt.Fprintf(w, "dmetrics_power_watts{component=\"cpu\"} %g\n", stats.CPUPower)
+	fmt.Fprintf(w, "dmetrics_power_watts{component=\"gpu\"} %g\n", stats.GPUPower)
+	fmt.Fprintf(w, "dmetrics_power_watts{component=\"total\"} %g\n", stats.TotalPower)
+
+	if !stats.IsPresent {
+		return nil
+	}
+
+	writeHelpType(w, "dmetrics_battery_percent", "Current battery charge percentage.", "gauge")
+	fmt.Fprintf(w, "dmetrics_battery_percent %g\n", stats.Percentage)
+
+	writeHelpType(w, "dmetrics_battery_cycle_count", "Battery charge cycle count.", "counter")
+	fmt.Fprintf(w, "dmetrics_battery_cycle_count %d\n", stats.CycleCount)
+
+	writeHelpType(w, "dmetrics_battery_time_remaining_seconds", "Estimated time to empty (discharging) or full (charging); negative while charging.", "gauge")
+	fmt.Fprintf(w, "dmetrics_battery_time_remaining_seconds %g\n", stats.TimeRemaining.Seconds())
+
+	healthRatio, err := c.power.GetBatteryHealthPercentage(ctx)
+	if err == nil {
+		writeHelpType(w, "dmetrics_battery_health_ratio", "MaxCapacity/DesignCapacity ratio as a percentage.", "gauge")
+		fmt.Fprintf(w, "dmetrics_battery_health_ratio %g\n", healthRatio)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+// Package prometheus exposes CPU and power metrics in the Prometheus text
+// exposition format over an http.Handler, so the module can act as a
+// drop-in node-exporter-style scrape target for macOS hosts.
+//
+// Example usage:
+//
+//	cpuProvider := cpu.NewProvider()
+//	powerProvider := power.NewProvider()
+//	defer cpuProvider.Shutdown()
+//	defer powerProvider.Shutdown()
+//
+//	handler := prometheus.NewHandler(cpuProvider, powerProvider)
+//	http.Handle("/metrics", handler)
+//	log.Fatal(http.ListenAndServe(":9100", nil))
+//
+// Every scrape reads fresh values via GetStats; no metrics are cached
+// between requests.
+package prometheus
@@ -0,0 +1,118 @@
+package prometheus
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// contentType is the Prometheus text exposition format content type.
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Handler serves CPU and power metrics in the Prometheus text exposition
+// format. A Handler is safe for concurrent use by multiple goroutines.
+type Handler struct {
+	cpu   metrics.CPUMetrics
+	power metrics.PowerMetrics
+}
+
+// NewHandler creates a Handler that scrapes the given providers on every
+// request. power may be nil if battery/power metrics are not available on
+// the current platform.
+func NewHandler(cpu metrics.CPUMetrics, power metrics.PowerMetrics) *Handler {
+	return &Handler{cpu: cpu, power: power}
+}
+
+// ServeHTTP implements http.Handler. It collects a fresh sample from each
+// configured provider and writes it out in Prometheus text format. If the
+// request context is already cancelled, it returns without writing a body.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.Context().Err(); err != nil {
+		http.Error(w, "request cancelled", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+
+	h.writeCPUMetrics(w, r)
+	if h.power != nil {
+		h.writePowerMetrics(w, r)
+	}
+}
+
+func (h *Handler) writeCPUMetrics(w io.Writer, r *http.Request) {
+	stats, err := h.cpu.GetStats(r.Context())
+	if err != nil {
+		fmt.Fprintf(w, "# failed to collect CPU stats: %v\n", err)
+		return
+	}
+
+	writeHelpType(w, "dmetrics_cpu_usage_percent", "CPU time spent in each mode, normalised to [0,100].", "gauge")
+	fmt.Fprintf(w, "dmetrics_cpu_usage_percent{mode=\"total\"} %g\n", stats.TotalUsage)
+	fmt.Fprintf(w, "dmetrics_cpu_usage_percent{mode=\"user\"} %g\n", stats.User)
+	fmt.Fprintf(w, "dmetrics_cpu_usage_percent{mode=\"system\"} %g\n", stats.System)
+	fmt.Fprintf(w, "dmetrics_cpu_usage_percent{mode=\"idle\"} %g\n", stats.Idle)
+	fmt.Fprintf(w, "dmetrics_cpu_usage_percent{mode=\"nice\"} %g\n", stats.Nice)
+
+	writeHelpType(w, "dmetrics_cpu_core_usage_percent", "Per-core CPU usage percentage.", "gauge")
+	for i, usage := range stats.CoreUsage {
+		fmt.Fprintf(w, "dmetrics_cpu_core_usage_percent{core=\"%d\",type=\"%s\"} %g\n", i, coreType(stats, i), usage)
+	}
+
+	writeHelpType(w, "dmetrics_cpu_frequency_mhz", "Current CPU frequency in MHz.", "gauge")
+	if freq, err := h.cpu.GetPerformanceFrequency(); err == nil {
+		fmt.Fprintf(w, "dmetrics_cpu_frequency_mhz{type=\"P\"} %d\n", freq)
+	}
+	if freq, err := h.cpu.GetEfficiencyFrequency(); err == nil {
+		fmt.Fprintf(w, "dmetrics_cpu_frequency_mhz{type=\"E\"} %d\n", freq)
+	}
+
+	writeHelpType(w, "dmetrics_load_average", "System load average.", "gauge")
+	windows := [3]string{"1m", "5m", "15m"}
+	for i, window := range windows {
+		fmt.Fprintf(w, "dmetrics_load_average{window=\"%s\"} %g\n", window, stats.LoadAvg[i])
+	}
+}
+
+func (h *Handler) writePowerMetrics(w io.Writer, r *http.Request) {
+	stats, err := h.power.GetStats(r.Context())
+	if err != nil {
+		fmt.Fprintf(w, "# failed to collect power stats: %v\n", err)
+		return
+	}
+	if !stats.IsPresent {
+		return
+	}
+
+	writeHelpType(w, "dmetrics_battery_percentage", "Current battery charge percentage.", "gauge")
+	fmt.Fprintf(w, "dmetrics_battery_percentage %g\n", stats.Percentage)
+
+	writeHelpType(w, "dmetrics_battery_cycle_count", "Battery charge cycle count.", "gauge")
+	fmt.Fprintf(w, "dmetrics_battery_cycle_count %d\n", stats.CycleCount)
+
+	writeHelpType(w, "dmetrics_battery_health_info", "Battery health classification, one active time series per scrape.", "gauge")
+	fmt.Fprintf(w, "dmetrics_battery_health_info{health=\"%s\"} 1\n", stats.Health)
+}
+
+// coreType reports whether core i is a performance or efficiency core,
+// assuming performance cores are enumerated before efficiency cores as
+// the rest of the module does (see examples/cpu/cpu_stats.go).
+func coreType(stats *types.CPUStats, i int) string {
+	if i < stats.PerformanceCores {
+		return "P"
+	}
+	if stats.EfficiencyCores > 0 {
+		return "E"
+	}
+	return "unknown"
+}
+
+// writeHelpType writes the HELP and TYPE comment lines Prometheus expects
+// to precede the first sample of a metric family.
+func writeHelpType(w io.Writer, name, help, metricType string) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, metricType)
+}
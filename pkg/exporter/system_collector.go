@@ -0,0 +1,37 @@
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+)
+
+// systemCollector collects dmetrics_system_* metrics from a SystemMetrics
+// provider.
+type systemCollector struct {
+	system metrics.SystemMetrics
+}
+
+// NewSystemCollector creates a Collector that reports system uptime and
+// boot time from provider, so monitoring pipelines can alert on unexpected
+// reboots.
+func NewSystemCollector(provider metrics.SystemMetrics) Collector {
+	return &systemCollector{system: provider}
+}
+
+func (c *systemCollector) Collect(ctx context.Context, w io.Writer) error {
+	stats, err := c.system.GetStats(ctx)
+	if err != nil {
+		return fmt.Errorf("collect system stats: %w", err)
+	}
+
+	writeHelpType(w, "dmetrics_system_uptime_seconds", "Time since the system was last booted, in seconds.", "gauge")
+	fmt.Fprintf(w, "dmetrics_system_uptime_seconds %g\n", stats.UptimeSeconds)
+
+	writeHelpType(w, "dmetrics_system_boot_time_seconds", "Unix timestamp of the system's last boot.", "gauge")
+	fmt.Fprintf(w, "dmetrics_system_boot_time_seconds %d\n", stats.BootTime.Unix())
+
+	return nil
+}
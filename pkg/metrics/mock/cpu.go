@@ -0,0 +1,291 @@
+package mock
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// CPUProvider is a fake metrics.CPUMetrics implementation that serves stats
+// from a fixed, caller-supplied sequence rather than reading real hardware,
+// mirroring PowerProvider. It's most useful for exercising per-core load
+// patterns (a sequence with varying CoreUsage) without needing the cgo
+// platform providers to be available.
+type CPUProvider struct {
+	mu       sync.Mutex
+	samples  []types.CPUStats
+	index    int
+	platform types.CPUPlatform
+}
+
+// NewCPUProvider creates a CPUProvider that replays samples in order,
+// reporting platform for GetPlatform and the core-count/frequency getters.
+// Panics if samples is empty.
+func NewCPUProvider(samples []types.CPUStats, platform types.CPUPlatform) *CPUProvider {
+	if len(samples) == 0 {
+		panic("mock: NewCPUProvider requires at least one sample")
+	}
+	cp := make([]types.CPUStats, len(samples))
+	copy(cp, samples)
+	return &CPUProvider{samples: cp, platform: platform}
+}
+
+// current returns a copy of the sample at the current index, advancing the
+// index unless it is already at the last sample.
+func (p *CPUProvider) current() types.CPUStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	s := p.samples[p.index]
+	if p.index < len(p.samples)-1 {
+		p.index++
+	}
+	return s
+}
+
+// GetFrequency returns the platform's FrequencyMHz.
+func (p *CPUProvider) GetFrequency() (uint64, error) {
+	return p.platform.FrequencyMHz, nil
+}
+
+// GetPerformanceFrequency returns the platform's PerfFrequencyMHz.
+func (p *CPUProvider) GetPerformanceFrequency() (uint64, error) {
+	if !p.platform.IsAppleSilicon {
+		return 0, metrics.ErrUnsupportedPlatform
+	}
+	return p.platform.PerfFrequencyMHz, nil
+}
+
+// GetEfficiencyFrequency returns the platform's EffiFrequencyMHz.
+func (p *CPUProvider) GetEfficiencyFrequency() (uint64, error) {
+	if !p.platform.IsAppleSilicon {
+		return 0, metrics.ErrUnsupportedPlatform
+	}
+	return p.platform.EffiFrequencyMHz, nil
+}
+
+// GetCoreCount returns the platform's PerformanceCores+EfficiencyCores, or
+// the current sample's PhysicalCores on Intel-shaped platforms.
+func (p *CPUProvider) GetCoreCount() (int, error) {
+	s := p.samples[0]
+	return s.PhysicalCores, nil
+}
+
+// GetPerformanceCoreCount returns the platform's PerformanceCores.
+func (p *CPUProvider) GetPerformanceCoreCount() (int, error) {
+	if !p.platform.IsAppleSilicon {
+		return 0, metrics.ErrUnsupportedPlatform
+	}
+	return p.platform.PerformanceCores, nil
+}
+
+// GetEfficiencyCoreCount returns the platform's EfficiencyCores.
+func (p *CPUProvider) GetEfficiencyCoreCount() (int, error) {
+	if !p.platform.IsAppleSilicon {
+		return 0, metrics.ErrUnsupportedPlatform
+	}
+	return p.platform.EfficiencyCores, nil
+}
+
+// GetStats returns the next sample in the sequence.
+func (p *CPUProvider) GetStats(_ context.Context) (*types.CPUStats, error) {
+	s := p.current()
+	return &s, nil
+}
+
+// GetContainerStats returns the next sample, ignoring previous; the mock
+// has no notion of a sample window.
+func (p *CPUProvider) GetContainerStats(ctx context.Context, _ *types.CPUStats) (*types.CPUStats, error) {
+	return p.GetStats(ctx)
+}
+
+// GetPerCoreStats derives CoreStats from the next sample's CoreUsage,
+// reporting every core as CoreTypeUnknown since CPUStats.CoreUsage doesn't
+// track per-core type.
+func (p *CPUProvider) GetPerCoreStats(_ context.Context) ([]types.CoreStats, error) {
+	s := p.current()
+	out := make([]types.CoreStats, len(s.CoreUsage))
+	for i, usage := range s.CoreUsage {
+		out[i] = types.CoreStats{
+			CoreID:    i,
+			CoreType:  types.CoreTypeUnknown,
+			User:      usage,
+			Timestamp: s.Timestamp,
+		}
+	}
+	return out, nil
+}
+
+// WatchPerCore sends one GetPerCoreStats result per tick until ctx is
+// cancelled.
+func (p *CPUProvider) WatchPerCore(ctx context.Context, interval time.Duration) (<-chan []types.CoreStats, error) {
+	if interval <= 0 {
+		return nil, types.ErrInvalidInterval
+	}
+	ch := make(chan []types.CoreStats)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s, _ := p.GetPerCoreStats(ctx)
+				select {
+				case ch <- s:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// GetInterrupts returns the next sample's interrupt/context-switch/syscall/
+// trap counters.
+func (p *CPUProvider) GetInterrupts(_ context.Context) (*types.InterruptStats, error) {
+	s := p.current()
+	return &types.InterruptStats{
+		Interrupts:      s.Interrupts,
+		ContextSwitches: s.ContextSwitches,
+		Syscalls:        s.Syscalls,
+		Traps:           s.Traps,
+		Timestamp:       s.Timestamp,
+	}, nil
+}
+
+// GetStatsWithOptions returns the next sample unmodified; the mock always
+// reports a full sample regardless of opts.
+func (p *CPUProvider) GetStatsWithOptions(ctx context.Context, _ types.CollectOptions) (*types.CPUStats, error) {
+	return p.GetStats(ctx)
+}
+
+// GetStatsFiltered returns the next sample unmodified; the mock doesn't
+// apply sel, since tests that need a filtered result can filter the
+// sequence themselves before constructing the provider.
+func (p *CPUProvider) GetStatsFiltered(ctx context.Context, _ types.CoreSelector) (*types.CPUStats, error) {
+	return p.GetStats(ctx)
+}
+
+// WatchFiltered sends one GetStats result per tick until ctx is cancelled,
+// ignoring sel for the same reason GetStatsFiltered does.
+func (p *CPUProvider) WatchFiltered(ctx context.Context, interval time.Duration, _ types.CoreSelector) (<-chan *types.CPUStats, error) {
+	return p.Watch(ctx, interval)
+}
+
+// WatchWithSelector sends one GetStats result per tick until ctx is
+// cancelled, ignoring opts.Selector for the same reason GetStatsFiltered
+// does, and leaving CoreUsageByIndex nil.
+func (p *CPUProvider) WatchWithSelector(ctx context.Context, opts types.WatchOptions) (<-chan *types.CPUStats, error) {
+	return p.Watch(ctx, opts.Interval)
+}
+
+// GetUptime returns the next sample's Uptime.
+func (p *CPUProvider) GetUptime(_ context.Context) (time.Duration, error) {
+	s := p.current()
+	return s.Uptime, nil
+}
+
+// GetBootTime returns the next sample's BootTime.
+func (p *CPUProvider) GetBootTime(_ context.Context) (time.Time, error) {
+	s := p.current()
+	return s.BootTime, nil
+}
+
+// GetPlatform returns the platform passed to NewCPUProvider.
+func (p *CPUProvider) GetPlatform() (*types.CPUPlatform, error) {
+	platform := p.platform
+	return &platform, nil
+}
+
+// GetCoreFrequencies derives per-core frequencies from the next sample's
+// CoreUsage length, reporting the platform's PerfFrequencyMHz for every
+// core since CPUStats doesn't track true per-core frequency.
+func (p *CPUProvider) GetCoreFrequencies() ([]uint64, error) {
+	if !p.platform.IsAppleSilicon {
+		return nil, metrics.ErrUnsupportedPlatform
+	}
+	s := p.current()
+	freqs := make([]uint64, len(s.CoreUsage))
+	for i := range freqs {
+		freqs[i] = p.platform.PerfFrequencyMHz
+	}
+	return freqs, nil
+}
+
+// GetCoreResidencies derives CoreResidency entries from the next sample's
+// CoreUsage, treating usage as ActiveResidencyPct since the mock has no
+// notion of DVFM-state residency.
+func (p *CPUProvider) GetCoreResidencies() ([]types.CoreResidency, error) {
+	if !p.platform.IsAppleSilicon {
+		return nil, metrics.ErrUnsupportedPlatform
+	}
+	s := p.current()
+	out := make([]types.CoreResidency, len(s.CoreUsage))
+	for i, usage := range s.CoreUsage {
+		out[i] = types.CoreResidency{
+			CoreIndex:          i,
+			FrequencyMHz:       p.platform.PerfFrequencyMHz,
+			ActiveResidencyPct: usage,
+			IdleResidencyPct:   100 - usage,
+		}
+	}
+	return out, nil
+}
+
+// GetUsageNanoCores returns the next sample's UsageNanoCores directly,
+// without the real providers' EWMA smoothing; the mock's samples are
+// caller-supplied, so there's no raw delta to smooth.
+func (p *CPUProvider) GetUsageNanoCores(_ context.Context) (uint64, error) {
+	s := p.current()
+	return s.UsageNanoCores, nil
+}
+
+// Watch sends one sample per tick, advancing through the sequence the same
+// way GetStats does, until ctx is cancelled.
+func (p *CPUProvider) Watch(ctx context.Context, interval time.Duration) (<-chan *types.CPUStats, error) {
+	if interval <= 0 {
+		return nil, types.ErrInvalidInterval
+	}
+
+	ch := make(chan *types.CPUStats, 1)
+	go func() {
+		defer close(ch)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s := p.current()
+				select {
+				case ch <- &s:
+				default:
+					select {
+					case <-ch:
+					default:
+					}
+					select {
+					case ch <- &s:
+					default:
+					}
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// Shutdown is a no-op; CPUProvider holds no resources to release.
+func (p *CPUProvider) Shutdown() error {
+	return nil
+}
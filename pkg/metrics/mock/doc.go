@@ -0,0 +1,11 @@
+// Package mock provides fake implementations of the api/metrics provider
+// interfaces, driven by a caller-supplied sequence of samples instead of
+// real hardware. It exists so tests that exercise provider-consumer logic
+// (battery health thresholds, usage deltas, exporters) can run
+// deterministically on hardware that doesn't have the feature under test —
+// a desktop with no battery, or a CI container — rather than skipping.
+//
+// See pkg/metrics/replay for recording real provider output to an NDJSON
+// file and replaying it through these providers later, and for generating
+// synthetic battery discharge curves without a recording at all.
+package mock
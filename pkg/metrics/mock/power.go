@@ -0,0 +1,168 @@
+package mock
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// PowerProvider is a fake metrics.PowerMetrics implementation that serves
+// stats from a fixed, caller-supplied sequence rather than reading real
+// hardware. Each call advances to the next sample; once the sequence is
+// exhausted it holds at the last entry instead of wrapping, so a test can
+// assert on a final steady state (e.g. "battery empty").
+type PowerProvider struct {
+	mu      sync.Mutex
+	samples []types.PowerStats
+	index   int
+}
+
+// NewPowerProvider creates a PowerProvider that replays samples in order.
+// Panics if samples is empty, since a provider with nothing to serve is
+// always a test-setup bug.
+func NewPowerProvider(samples []types.PowerStats) *PowerProvider {
+	if len(samples) == 0 {
+		panic("mock: NewPowerProvider requires at least one sample")
+	}
+	cp := make([]types.PowerStats, len(samples))
+	copy(cp, samples)
+	return &PowerProvider{samples: cp}
+}
+
+// current returns a copy of the sample at the current index, advancing the
+// index unless it is already at the last sample.
+func (p *PowerProvider) current() types.PowerStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	s := p.samples[p.index]
+	if p.index < len(p.samples)-1 {
+		p.index++
+	}
+	return s
+}
+
+// GetStats returns the next sample in the sequence.
+func (p *PowerProvider) GetStats(ctx context.Context) (*types.PowerStats, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	s := p.current()
+	return &s, nil
+}
+
+// GetPowerSource returns the Source field of the next sample.
+func (p *PowerProvider) GetPowerSource(ctx context.Context) (types.PowerSource, error) {
+	if ctx.Err() != nil {
+		return types.PowerSourceUnknown, ctx.Err()
+	}
+	return p.current().Source, nil
+}
+
+// GetBatteryPercentage returns the Percentage field of the next sample.
+func (p *PowerProvider) GetBatteryPercentage(ctx context.Context) (float64, error) {
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+	s := p.current()
+	if !s.IsPresent {
+		return 0, types.ErrNoBattery
+	}
+	return s.Percentage, nil
+}
+
+// GetBatteryPresent returns the IsPresent field of the next sample.
+func (p *PowerProvider) GetBatteryPresent(ctx context.Context) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	return p.current().IsPresent, nil
+}
+
+// GetBatteryHealth returns the Health field of the next sample.
+func (p *PowerProvider) GetBatteryHealth(ctx context.Context) (types.BatteryHealth, error) {
+	if ctx.Err() != nil {
+		return types.BatteryHealthUnknown, ctx.Err()
+	}
+	s := p.current()
+	if !s.IsPresent {
+		return types.BatteryHealthUnknown, types.ErrNoBattery
+	}
+	return s.Health, nil
+}
+
+// GetBatteryHealthPercentage returns the MaxCapacity/DesignCapacity ratio of
+// the next sample, as a percentage.
+func (p *PowerProvider) GetBatteryHealthPercentage(ctx context.Context) (float64, error) {
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+	s := p.current()
+	if !s.IsPresent || s.DesignCapacity <= 0 {
+		return 0, types.ErrNoBattery
+	}
+	return (s.MaxCapacity / s.DesignCapacity) * 100.0, nil
+}
+
+// GetTimeRemaining returns the TimeRemaining field of the next sample, or
+// TimeToFull negated when charging, matching the sign convention the
+// Darwin provider uses.
+func (p *PowerProvider) GetTimeRemaining(ctx context.Context) (time.Duration, error) {
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+	s := p.current()
+	if !s.IsPresent {
+		return 0, types.ErrNoBattery
+	}
+	if s.State == types.BatteryStateCharging {
+		return -s.TimeToFull, nil
+	}
+	return s.TimeRemaining, nil
+}
+
+// GetPowerConsumption returns the TotalPower field of the next sample.
+func (p *PowerProvider) GetPowerConsumption(ctx context.Context) (float64, error) {
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+	return p.current().TotalPower, nil
+}
+
+// Watch sends one sample per tick, advancing through the sequence the same
+// way GetStats does, until ctx is cancelled.
+func (p *PowerProvider) Watch(ctx context.Context, interval time.Duration) (<-chan *types.PowerStats, error) {
+	if interval <= 0 {
+		return nil, types.ErrInvalidInterval
+	}
+
+	ch := make(chan *types.PowerStats)
+	go func() {
+		defer close(ch)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s := p.current()
+				select {
+				case ch <- &s:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// Shutdown is a no-op; PowerProvider holds no resources to release.
+func (p *PowerProvider) Shutdown() error {
+	return nil
+}
@@ -0,0 +1,82 @@
+package mock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+func TestPowerProviderAdvancesAndHolds(t *testing.T) {
+	p := NewPowerProvider([]types.PowerStats{
+		{IsPresent: true, Percentage: 80},
+		{IsPresent: true, Percentage: 50},
+	})
+	ctx := context.Background()
+
+	first, err := p.GetStats(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, 80.0, first.Percentage, 0.0)
+
+	second, err := p.GetStats(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, 50.0, second.Percentage, 0.0)
+
+	// Sequence exhausted: holds at the last sample rather than wrapping.
+	third, err := p.GetStats(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, 50.0, third.Percentage, 0.0)
+}
+
+func TestPowerProviderNoBattery(t *testing.T) {
+	p := NewPowerProvider([]types.PowerStats{{IsPresent: false}})
+	ctx := context.Background()
+
+	_, err := p.GetBatteryPercentage(ctx)
+	assert.ErrorIs(t, err, types.ErrNoBattery)
+
+	_, err = p.GetBatteryHealth(ctx)
+	assert.ErrorIs(t, err, types.ErrNoBattery)
+}
+
+func TestPowerProviderTimeRemainingSignConvention(t *testing.T) {
+	p := NewPowerProvider([]types.PowerStats{
+		{IsPresent: true, State: types.BatteryStateDischarging, TimeRemaining: 30 * time.Minute},
+		{IsPresent: true, State: types.BatteryStateCharging, TimeToFull: 20 * time.Minute},
+	})
+	ctx := context.Background()
+
+	discharging, err := p.GetTimeRemaining(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, discharging)
+
+	charging, err := p.GetTimeRemaining(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, -20*time.Minute, charging)
+}
+
+func TestPowerProviderWatch(t *testing.T) {
+	p := NewPowerProvider([]types.PowerStats{{IsPresent: true, Percentage: 42}})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	ch, err := p.Watch(ctx, 10*time.Millisecond)
+	require.NoError(t, err)
+
+	select {
+	case stats := <-ch:
+		assert.InDelta(t, 42.0, stats.Percentage, 0.0)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for watch sample")
+	}
+}
+
+func TestPowerProviderRejectsEmptySequence(t *testing.T) {
+	assert.Panics(t, func() {
+		NewPowerProvider(nil)
+	})
+}
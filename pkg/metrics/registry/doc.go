@@ -0,0 +1,25 @@
+// Package registry provides a stable, self-describing pull API for the
+// module's metrics, modeled on the standard library's runtime/metrics
+// package. Consumers enumerate the available metrics with All, allocate a
+// []Sample naming the ones they want, and fill them in with Read — without
+// depending on the per-subsystem provider types directly. This makes it
+// trivial to bridge the module's metrics into OTel, Prometheus, statsd, or
+// any other exporter with a single adapter.
+//
+// Example usage:
+//
+//	reg := registry.NewRegistry(cpuProvider, powerProvider)
+//
+//	descs := reg.All()
+//	samples := make([]registry.Sample, len(descs))
+//	for i, d := range descs {
+//	    samples[i].Name = d.Name
+//	}
+//
+//	reg.Read(samples)
+//	for _, s := range samples {
+//	    if s.Value.Kind() == registry.KindFloat64 {
+//	        fmt.Printf("%s = %f\n", s.Name, s.Value.Float64())
+//	    }
+//	}
+package registry
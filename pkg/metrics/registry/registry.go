@@ -0,0 +1,149 @@
+package registry
+
+import (
+	"context"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+)
+
+// Kind describes the shape of a metric's value, distinct from ValueKind:
+// it classifies the metric itself (for documentation/introspection)
+// independent of any particular Sample.
+type Kind = ValueKind
+
+// Description describes one metric the registry can produce, analogous to
+// runtime/metrics.Description.
+type Description struct {
+	// Name is the metric's stable identifier, e.g. "/cpu/usage/total:percent".
+	Name string
+
+	// Description is a human-readable explanation of the metric.
+	Description string
+
+	// Kind is the ValueKind that Read will populate for this metric.
+	Kind Kind
+
+	// Cumulative indicates whether the metric is a monotonically
+	// increasing counter (true) or an instantaneous gauge (false).
+	Cumulative bool
+}
+
+// Sample pairs a metric Name with the Value that Read fills in.
+type Sample struct {
+	Name  string
+	Value Value
+}
+
+// Registry dispatches metric reads to the underlying CPU and power
+// providers. A Registry is safe for concurrent use if the providers it
+// wraps are.
+type Registry struct {
+	cpu   metrics.CPUMetrics
+	power metrics.PowerMetrics
+}
+
+// NewRegistry creates a Registry over the given providers. power may be nil
+// if battery/power metrics are unavailable.
+func NewRegistry(cpu metrics.CPUMetrics, power metrics.PowerMetrics) *Registry {
+	return &Registry{cpu: cpu, power: power}
+}
+
+// descriptions enumerates every metric this Registry can produce. CPU
+// metrics are always listed; power metrics are only listed when the
+// Registry was constructed with a non-nil power provider.
+func (r *Registry) descriptions() []Description {
+	descs := []Description{
+		{Name: "/cpu/usage/total:percent", Description: "Total CPU usage, normalised to [0,100].", Kind: KindFloat64},
+		{Name: "/cpu/frequency/perf:mhz", Description: "Performance-core frequency in MHz (Apple Silicon only).", Kind: KindUint64},
+		{Name: "/cpu/frequency/effi:mhz", Description: "Efficiency-core frequency in MHz (Apple Silicon only).", Kind: KindUint64},
+		{Name: "/cpu/cores/perf:cores", Description: "Number of performance cores (Apple Silicon only).", Kind: KindUint64},
+		{Name: "/cpu/cores/effi:cores", Description: "Number of efficiency cores (Apple Silicon only).", Kind: KindUint64},
+	}
+
+	if r.power != nil {
+		descs = append(descs,
+			Description{Name: "/power/battery/percentage:percent", Description: "Current battery charge percentage.", Kind: KindFloat64},
+			Description{Name: "/power/battery/cycles:count", Description: "Battery charge cycle count.", Kind: KindUint64, Cumulative: true},
+			Description{Name: "/power/battery/health-ratio:percent", Description: "MaxCapacity/DesignCapacity ratio as a percentage.", Kind: KindFloat64},
+		)
+	}
+
+	return descs
+}
+
+// All returns the Description of every metric this Registry can produce.
+func (r *Registry) All() []Description {
+	return r.descriptions()
+}
+
+// Read fills in samples[i].Value for each samples[i].Name the Registry
+// recognises. Unknown names, or names whose underlying provider call
+// fails, are left with a zero Value (Kind() == KindBad), matching the
+// behaviour of runtime/metrics.Read for unsupported metrics.
+func (r *Registry) Read(samples []Sample) {
+	ctx := context.Background()
+	for i := range samples {
+		samples[i].Value = r.read(ctx, samples[i].Name)
+	}
+}
+
+func (r *Registry) read(ctx context.Context, name string) Value {
+	switch name {
+	case "/cpu/usage/total:percent":
+		stats, err := r.cpu.GetStats(ctx)
+		if err != nil {
+			return Value{}
+		}
+		return float64Value(stats.TotalUsage)
+	case "/cpu/frequency/perf:mhz":
+		freq, err := r.cpu.GetPerformanceFrequency()
+		if err != nil {
+			return Value{}
+		}
+		return uint64Value(freq)
+	case "/cpu/frequency/effi:mhz":
+		freq, err := r.cpu.GetEfficiencyFrequency()
+		if err != nil {
+			return Value{}
+		}
+		return uint64Value(freq)
+	case "/cpu/cores/perf:cores":
+		cores, err := r.cpu.GetPerformanceCoreCount()
+		if err != nil {
+			return Value{}
+		}
+		return uint64Value(uint64(cores))
+	case "/cpu/cores/effi:cores":
+		cores, err := r.cpu.GetEfficiencyCoreCount()
+		if err != nil {
+			return Value{}
+		}
+		return uint64Value(uint64(cores))
+	case "/power/battery/percentage:percent":
+		return r.readPowerFloat64(ctx, func() (float64, error) { return r.power.GetBatteryPercentage(ctx) })
+	case "/power/battery/cycles:count":
+		if r.power == nil {
+			return Value{}
+		}
+		stats, err := r.power.GetStats(ctx)
+		if err != nil {
+			return Value{}
+		}
+		return uint64Value(uint64(stats.CycleCount))
+	case "/power/battery/health-ratio:percent":
+		return r.readPowerFloat64(ctx, func() (float64, error) { return r.power.GetBatteryHealthPercentage(ctx) })
+	default:
+		return Value{}
+	}
+}
+
+func (r *Registry) readPowerFloat64(_ context.Context, fn func() (float64, error)) Value {
+	if r.power == nil {
+		return Value{}
+	}
+	v, err := fn()
+	if err != nil {
+		return Value{}
+	}
+	return float64Value(v)
+}
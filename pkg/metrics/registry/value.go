@@ -0,0 +1,88 @@
+package registry
+
+import "math"
+
+// ValueKind discriminates the representation held by a Value.
+type ValueKind int
+
+const (
+	// KindBad indicates the Value is unset, either because the sample name
+	// is unknown to the registry or the underlying provider failed to
+	// produce a reading.
+	KindBad ValueKind = iota
+	// KindUint64 indicates the Value holds a uint64, accessible via Uint64.
+	KindUint64
+	// KindFloat64 indicates the Value holds a float64, accessible via Float64.
+	KindFloat64
+	// KindFloat64Histogram indicates the Value holds a *Float64Histogram,
+	// accessible via Float64Histogram.
+	KindFloat64Histogram
+)
+
+// Float64Histogram is a bucketed distribution, analogous to
+// runtime/metrics.Float64Histogram. Counts[i] is the number of samples
+// falling in [Buckets[i], Buckets[i+1]).
+type Float64Histogram struct {
+	Counts  []uint64
+	Buckets []float64
+}
+
+// Value is a discriminated union over the possible representations a
+// metric Sample can hold. The zero Value has Kind() == KindBad.
+type Value struct {
+	kind      ValueKind
+	scalar    uint64 // raw uint64, or float64 bits when kind == KindFloat64
+	histogram *Float64Histogram
+}
+
+// Kind reports the representation held by v.
+func (v Value) Kind() ValueKind {
+	return v.kind
+}
+
+// Uint64 returns the value as a uint64. It panics if v.Kind() != KindUint64.
+func (v Value) Uint64() uint64 {
+	if v.kind != KindUint64 {
+		panic("registry: Uint64 called on Value of kind " + v.kind.String())
+	}
+	return v.scalar
+}
+
+// Float64 returns the value as a float64. It panics if v.Kind() != KindFloat64.
+func (v Value) Float64() float64 {
+	if v.kind != KindFloat64 {
+		panic("registry: Float64 called on Value of kind " + v.kind.String())
+	}
+	return math.Float64frombits(v.scalar)
+}
+
+// Float64Histogram returns the value as a *Float64Histogram. It panics if
+// v.Kind() != KindFloat64Histogram.
+func (v Value) Float64Histogram() *Float64Histogram {
+	if v.kind != KindFloat64Histogram {
+		panic("registry: Float64Histogram called on Value of kind " + v.kind.String())
+	}
+	return v.histogram
+}
+
+// String returns a human-readable name for k, used in panic messages.
+func (k ValueKind) String() string {
+	switch k {
+	case KindUint64:
+		return "KindUint64"
+	case KindFloat64:
+		return "KindFloat64"
+	case KindFloat64Histogram:
+		return "KindFloat64Histogram"
+	default:
+		return "KindBad"
+	}
+}
+
+func uint64Value(v uint64) Value {
+	return Value{kind: KindUint64, scalar: v}
+}
+
+func float64Value(v float64) Value {
+	return Value{kind: KindFloat64, scalar: math.Float64bits(v)}
+}
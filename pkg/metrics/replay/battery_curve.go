@@ -0,0 +1,138 @@
+package replay
+
+import (
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// BatteryCurveOptions configures SimulatedBatteryCurve.
+type BatteryCurveOptions struct {
+	// DesignCapacity and MaxCapacity set the health ratio
+	// (MaxCapacity/DesignCapacity * 100) every sample reports, in
+	// Watt-hours.
+	DesignCapacity float64
+	MaxCapacity    float64
+
+	// CycleCount is the charge-cycle count every sample reports.
+	CycleCount int
+
+	// DischargeSteps is the number of samples spent discharging from 100%
+	// to 0% on battery power.
+	DischargeSteps int
+
+	// ChargeSteps is the number of samples spent charging back from 0% to
+	// 100% on AC power, appended after the discharge steps. Zero omits the
+	// charging leg, leaving the curve ending at 0% on battery power.
+	ChargeSteps int
+
+	// StepInterval is the Timestamp spacing between consecutive samples,
+	// and the basis for the TimeRemaining/TimeToFull estimates.
+	StepInterval time.Duration
+}
+
+// DefaultBatteryCurveOptions returns a full discharge-then-recharge cycle,
+// two hours each way, five minutes apart, for a lightly-used battery
+// (98% health ratio, 120 cycles).
+func DefaultBatteryCurveOptions() BatteryCurveOptions {
+	return BatteryCurveOptions{
+		DesignCapacity: 70.0,
+		MaxCapacity:    68.6,
+		CycleCount:     120,
+		DischargeSteps: 24,
+		ChargeSteps:    24,
+		StepInterval:   5 * time.Minute,
+	}
+}
+
+// healthRatioThresholds mirror internal/power/darwin's DefaultHealthPolicy
+// capacity-ratio axis; duplicated here rather than imported since replay
+// has no dependency on the Darwin-specific package, and the exact
+// classification a test sees only needs to be realistic, not identical to
+// whatever HealthPolicy the real provider under test is configured with.
+const (
+	healthRatioGoodPercent = 90.0
+	healthRatioFairPercent = 80.0
+)
+
+func classifyHealthRatio(maxCapacity, designCapacity float64) types.BatteryHealth {
+	if designCapacity <= 0 {
+		return types.BatteryHealthUnknown
+	}
+	ratio := (maxCapacity / designCapacity) * 100.0
+	switch {
+	case ratio < healthRatioFairPercent:
+		return types.BatteryHealthPoor
+	case ratio < healthRatioGoodPercent:
+		return types.BatteryHealthFair
+	default:
+		return types.BatteryHealthGood
+	}
+}
+
+// SimulatedBatteryCurve generates a sequence of PowerStats simulating a
+// battery discharging from full to empty on battery power, then — if
+// opts.ChargeSteps is non-zero — charging back to full on AC power. It
+// exercises the Source/State transitions and TimeRemaining/TimeToFull sign
+// conventions a real discharge cycle produces, without needing a recording
+// or real battery hardware.
+func SimulatedBatteryCurve(opts BatteryCurveOptions) []types.PowerStats {
+	health := classifyHealthRatio(opts.MaxCapacity, opts.DesignCapacity)
+	now := time.Time{}.Add(24 * time.Hour) // arbitrary fixed epoch; callers care about deltas, not wall time
+
+	samples := make([]types.PowerStats, 0, opts.DischargeSteps+opts.ChargeSteps)
+
+	for i := 0; i < opts.DischargeSteps; i++ {
+		frac := float64(i) / float64(maxInt(opts.DischargeSteps-1, 1))
+		pct := 100.0 * (1 - frac)
+		remainingSteps := opts.DischargeSteps - 1 - i
+		samples = append(samples, types.PowerStats{
+			Source:          types.PowerSourceBattery,
+			IsPresent:       true,
+			State:           types.BatteryStateDischarging,
+			Health:          health,
+			Percentage:      pct,
+			TimeRemaining:   time.Duration(remainingSteps) * opts.StepInterval,
+			CycleCount:      opts.CycleCount,
+			DesignCapacity:  opts.DesignCapacity,
+			MaxCapacity:     opts.MaxCapacity,
+			CurrentCapacity: opts.MaxCapacity * pct / 100.0,
+			Power:           15.0,
+			Timestamp:       now.Add(time.Duration(i) * opts.StepInterval),
+		})
+	}
+
+	dischargeElapsed := time.Duration(opts.DischargeSteps) * opts.StepInterval
+	for i := 0; i < opts.ChargeSteps; i++ {
+		frac := float64(i+1) / float64(opts.ChargeSteps)
+		pct := 100.0 * frac
+		remainingSteps := opts.ChargeSteps - 1 - i
+		state := types.BatteryStateCharging
+		if i == opts.ChargeSteps-1 {
+			state = types.BatteryStateFull
+		}
+		samples = append(samples, types.PowerStats{
+			Source:          types.PowerSourceAC,
+			IsPresent:       true,
+			State:           state,
+			Health:          health,
+			Percentage:      pct,
+			TimeToFull:      time.Duration(remainingSteps) * opts.StepInterval,
+			CycleCount:      opts.CycleCount,
+			DesignCapacity:  opts.DesignCapacity,
+			MaxCapacity:     opts.MaxCapacity,
+			CurrentCapacity: opts.MaxCapacity * pct / 100.0,
+			Power:           15.0,
+			Timestamp:       now.Add(dischargeElapsed + time.Duration(i)*opts.StepInterval),
+		})
+	}
+
+	return samples
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,59 @@
+package replay
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/mock"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+func TestSimulatedBatteryCurveDischargeThenCharge(t *testing.T) {
+	opts := DefaultBatteryCurveOptions()
+	samples := SimulatedBatteryCurve(opts)
+	require.Len(t, samples, opts.DischargeSteps+opts.ChargeSteps)
+
+	first, last := samples[0], samples[len(samples)-1]
+	assert.Equal(t, types.PowerSourceBattery, first.Source)
+	assert.InDelta(t, 100.0, first.Percentage, 0.01)
+
+	assert.Equal(t, types.PowerSourceAC, last.Source)
+	assert.Equal(t, types.BatteryStateFull, last.State)
+	assert.InDelta(t, 100.0, last.Percentage, 0.01)
+
+	dischargeEnd := samples[opts.DischargeSteps-1]
+	assert.Equal(t, types.PowerSourceBattery, dischargeEnd.Source)
+	assert.InDelta(t, 0.0, dischargeEnd.Percentage, 0.01)
+
+	chargeStart := samples[opts.DischargeSteps]
+	assert.Equal(t, types.PowerSourceAC, chargeStart.Source)
+	assert.Equal(t, types.BatteryStateCharging, chargeStart.State)
+}
+
+func TestSimulatedBatteryCurveHealthMatchesCapacityRatio(t *testing.T) {
+	samples := SimulatedBatteryCurve(BatteryCurveOptions{
+		DesignCapacity: 100,
+		MaxCapacity:    70, // 70% ratio -> Poor
+		DischargeSteps: 3,
+	})
+	for _, s := range samples {
+		assert.Equal(t, types.BatteryHealthPoor, s.Health)
+	}
+}
+
+func TestSimulatedBatteryCurveDrivesMockProvider(t *testing.T) {
+	samples := SimulatedBatteryCurve(DefaultBatteryCurveOptions())
+	p := mock.NewPowerProvider(samples)
+	ctx := context.Background()
+
+	pct, err := p.GetBatteryPercentage(ctx)
+	require.NoError(t, err)
+	assert.InDelta(t, 100.0, pct, 0.01)
+
+	health, err := p.GetBatteryHealth(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.BatteryHealthGood, health)
+}
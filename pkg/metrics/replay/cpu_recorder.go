@@ -0,0 +1,81 @@
+package replay
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/mock"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// cpuStatsProvider is the subset of metrics.CPUMetrics RecordCPU needs;
+// declared locally rather than depending on the full interface so callers
+// can record from a bare GetStats(ctx)-returning type in tests.
+type cpuStatsProvider interface {
+	GetStats(ctx context.Context) (*types.CPUStats, error)
+}
+
+// RecordCPU takes count samples from provider, spaced interval apart, and
+// writes one JSON-encoded types.CPUStats per line to w, mirroring
+// RecordPower. Useful for capturing a per-core load pattern (e.g. a
+// stress-test run) as a fixture for later replay.
+func RecordCPU(ctx context.Context, provider cpuStatsProvider, w io.Writer, count int, interval time.Duration) error {
+	enc := json.NewEncoder(w)
+	for i := 0; i < count; i++ {
+		stats, err := provider.GetStats(ctx)
+		if err != nil {
+			return fmt.Errorf("record cpu sample %d: %w", i, err)
+		}
+		if err := enc.Encode(stats); err != nil {
+			return fmt.Errorf("encode cpu sample %d: %w", i, err)
+		}
+		if i < count-1 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+		}
+	}
+	return nil
+}
+
+// LoadCPU reads one JSON-encoded types.CPUStats per line from r.
+func LoadCPU(r io.Reader) ([]types.CPUStats, error) {
+	var samples []types.CPUStats
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var s types.CPUStats
+		if err := json.Unmarshal(line, &s); err != nil {
+			return nil, fmt.Errorf("decode cpu sample: %w", err)
+		}
+		samples = append(samples, s)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read cpu samples: %w", err)
+	}
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("replay: no cpu samples in input")
+	}
+	return samples, nil
+}
+
+// NewCPUProvider loads CPU samples from r and wraps them in a
+// mock.CPUProvider alongside platform, so a recorded or synthetic fixture
+// can be replayed through the same metrics.CPUMetrics interface the real
+// provider satisfies.
+func NewCPUProvider(r io.Reader, platform types.CPUPlatform) (*mock.CPUProvider, error) {
+	samples, err := LoadCPU(r)
+	if err != nil {
+		return nil, err
+	}
+	return mock.NewCPUProvider(samples, platform), nil
+}
@@ -0,0 +1,7 @@
+// Package replay records real provider output to an NDJSON file and
+// replays it deterministically through a pkg/metrics/mock provider, and
+// generates synthetic battery discharge curves for tests that need battery
+// transitions but have no recording at all. It's the on-disk counterpart to
+// pkg/metrics/mock: mock holds the fake-provider mechanics, replay supplies
+// the sample data.
+package replay
@@ -0,0 +1,75 @@
+package replay
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/mock"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// RecordPower takes count samples from provider, spaced interval apart, and
+// writes one JSON-encoded types.PowerStats per line to w. It's meant to be
+// run once, interactively, on real Darwin hardware to capture a fixture;
+// the resulting file is then checked in and loaded with LoadPower.
+func RecordPower(ctx context.Context, provider metrics.PowerMetrics, w io.Writer, count int, interval time.Duration) error {
+	enc := json.NewEncoder(w)
+	for i := 0; i < count; i++ {
+		stats, err := provider.GetStats(ctx)
+		if err != nil {
+			return fmt.Errorf("record power sample %d: %w", i, err)
+		}
+		if err := enc.Encode(stats); err != nil {
+			return fmt.Errorf("encode power sample %d: %w", i, err)
+		}
+		if i < count-1 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+		}
+	}
+	return nil
+}
+
+// LoadPower reads one JSON-encoded types.PowerStats per line from r.
+func LoadPower(r io.Reader) ([]types.PowerStats, error) {
+	var samples []types.PowerStats
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var s types.PowerStats
+		if err := json.Unmarshal(line, &s); err != nil {
+			return nil, fmt.Errorf("decode power sample: %w", err)
+		}
+		samples = append(samples, s)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read power samples: %w", err)
+	}
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("replay: no power samples in input")
+	}
+	return samples, nil
+}
+
+// NewPowerProvider loads power samples from r and wraps them in a
+// mock.PowerProvider, so a recorded or synthetic fixture can be replayed
+// through the same metrics.PowerMetrics interface the real provider
+// satisfies.
+func NewPowerProvider(r io.Reader) (*mock.PowerProvider, error) {
+	samples, err := LoadPower(r)
+	if err != nil {
+		return nil, err
+	}
+	return mock.NewPowerProvider(samples), nil
+}
@@ -0,0 +1,55 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/mock"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+func TestRecordAndLoadPowerRoundTrip(t *testing.T) {
+	source := mock.NewPowerProvider(SimulatedBatteryCurve(BatteryCurveOptions{
+		DesignCapacity: 70,
+		MaxCapacity:    68.6,
+		DischargeSteps: 3,
+		StepInterval:   time.Minute,
+	}))
+
+	var buf bytes.Buffer
+	require.NoError(t, RecordPower(context.Background(), source, &buf, 3, time.Millisecond))
+
+	replayed, err := NewPowerProvider(&buf)
+	require.NoError(t, err)
+
+	stats, err := replayed.GetStats(context.Background())
+	require.NoError(t, err)
+	assert.InDelta(t, 100.0, stats.Percentage, 0.01)
+}
+
+func TestLoadPowerRejectsEmptyInput(t *testing.T) {
+	_, err := LoadPower(bytes.NewReader(nil))
+	assert.Error(t, err)
+}
+
+func TestRecordAndLoadCPURoundTrip(t *testing.T) {
+	source := mock.NewCPUProvider([]types.CPUStats{
+		{PhysicalCores: 8, CoreUsage: []float64{10, 20, 30, 40, 0, 0, 0, 0}},
+	}, types.CPUPlatform{PerformanceCores: 4, EfficiencyCores: 4})
+
+	var buf bytes.Buffer
+	require.NoError(t, RecordCPU(context.Background(), source, &buf, 1, 0))
+
+	replayed, err := NewCPUProvider(&buf, types.CPUPlatform{})
+	require.NoError(t, err)
+
+	stats, err := replayed.GetStats(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 8, stats.PhysicalCores)
+	assert.Len(t, stats.CoreUsage, 8)
+}
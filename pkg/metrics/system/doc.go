@@ -0,0 +1,24 @@
+// Package system provides system-level metrics — uptime, boot time,
+// hostname, and kernel version. On macOS (Darwin), it reads sysctl
+// kern.boottime and kern.osrelease via cgo. On FreeBSD, it shells out to
+// sysctl(8) instead, mirroring internal/cpu/freebsd and
+// internal/power/freebsd. Other platforms get a stub provider whose
+// methods all return metrics.ErrUnsupportedPlatform.
+//
+// SystemStats reports uptime both as a time.Duration/float64 seconds pair
+// and as a human-readable string, so it can feed numeric exporters (see
+// pkg/exporter) and Nagios-style checks (see pkg/check) that alert on
+// unexpectedly low uptime indicating a recent reboot, as well as
+// human-facing output.
+//
+// Example usage:
+//
+//	provider := system.NewProvider()
+//	defer provider.Shutdown()
+//
+//	stats, err := provider.GetStats(context.Background())
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Up %s since %s\n", stats.UptimeString, stats.BootTime)
+package system
@@ -0,0 +1,7 @@
+package system
+
+import "errors"
+
+// ErrBootTimeUnavailable is returned when the sysctl kern.boottime call
+// fails.
+var ErrBootTimeUnavailable = errors.New("boot time unavailable")
@@ -0,0 +1,32 @@
+package system
+
+import (
+	"fmt"
+	"time"
+)
+
+// formatUptime renders d as a human-readable string like "3 days, 4 hours",
+// "2 hours, 15 minutes", or "45 minutes", dropping the larger unit once it
+// is zero.
+func formatUptime(d time.Duration) string {
+	days := int(d.Hours()) / 24
+	hours := int(d.Hours()) % 24
+	minutes := int(d.Minutes()) % 60
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%s, %s", pluralize(days, "day"), pluralize(hours, "hour"))
+	case hours > 0:
+		return fmt.Sprintf("%s, %s", pluralize(hours, "hour"), pluralize(minutes, "minute"))
+	default:
+		return pluralize(minutes, "minute")
+	}
+}
+
+// pluralize renders n followed by unit, pluralized unless n == 1.
+func pluralize(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
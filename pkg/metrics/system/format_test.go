@@ -0,0 +1,29 @@
+package system
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatUptime(t *testing.T) {
+	tests := []struct {
+		name string
+		d    time.Duration
+		want string
+	}{
+		{"minutes", 45 * time.Minute, "45 minutes"},
+		{"one minute", 1 * time.Minute, "1 minute"},
+		{"hours and minutes", 2*time.Hour + 15*time.Minute, "2 hours, 15 minutes"},
+		{"one hour", 1*time.Hour + 1*time.Minute, "1 hour, 1 minute"},
+		{"days and hours", 3*24*time.Hour + 4*time.Hour, "3 days, 4 hours"},
+		{"one day", 24*time.Hour + 0*time.Hour, "1 day, 0 hours"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, formatUptime(tt.d))
+		})
+	}
+}
@@ -0,0 +1,166 @@
+//go:build darwin
+// +build darwin
+
+package system
+
+/*
+#include <sys/sysctl.h>
+#include <sys/time.h>
+#include <stdlib.h>
+
+static int get_boot_time(struct timeval *tv) {
+	int mib[2] = {CTL_KERN, KERN_BOOTTIME};
+	size_t size = sizeof(*tv);
+	return sysctl(mib, 2, tv, &size, NULL, 0);
+}
+
+static int get_osrelease(char *buf, size_t size) {
+	int mib[2] = {CTL_KERN, KERN_OSRELEASE};
+	return sysctl(mib, 2, buf, &size, NULL, 0);
+}
+*/
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+	"unsafe"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// osreleaseBufSize bounds the buffer used to read sysctl kern.osrelease,
+// comfortably larger than any real kernel release string.
+const osreleaseBufSize = 256
+
+// Provider implements system metrics collection (uptime, boot time) for
+// Darwin systems via sysctl kern.boottime. All methods are thread-safe
+// and can be called from multiple goroutines.
+type Provider struct{}
+
+// var _ asserts that Provider implements metrics.SystemMetrics at compile
+// time, so a signature drift between the two fails the build here instead
+// of surfacing as an opaque "does not implement" error at a call site.
+var _ metrics.SystemMetrics = (*Provider)(nil)
+
+// NewProvider creates a new Darwin system metrics provider.
+func NewProvider() *Provider {
+	return &Provider{}
+}
+
+// BootTime returns the time the system was last booted.
+func (p *Provider) BootTime(ctx context.Context) (time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return time.Time{}, err
+	}
+	return bootTime()
+}
+
+// Uptime returns the duration the system has been running since boot.
+func (p *Provider) Uptime(ctx context.Context) (time.Duration, error) {
+	boot, err := p.BootTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return time.Since(boot), nil
+}
+
+// GetStats returns current system statistics, including both a
+// human-readable and a precise rendering of uptime, plus the hostname and
+// kernel version.
+func (p *Provider) GetStats(ctx context.Context) (*types.SystemStats, error) {
+	boot, err := p.BootTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uptime := time.Since(boot)
+	return &types.SystemStats{
+		BootTime:      boot,
+		Uptime:        uptime,
+		UptimeSeconds: uptime.Seconds(),
+		UptimeString:  formatUptime(uptime),
+		Hostname:      hostname(),
+		KernelVersion: kernelVersion(),
+		Timestamp:     time.Now(),
+	}, nil
+}
+
+// Watch monitors system statistics and sends updates through the returned
+// channel. The interval parameter determines how often updates are sent.
+// The context can be used to stop monitoring. When the context is
+// cancelled, the channel will be closed after any pending updates are sent.
+//
+// The returned channel is buffered with a capacity of 1, mirroring the CPU
+// and power providers' Watch methods.
+func (p *Provider) Watch(ctx context.Context, interval time.Duration) (<-chan *types.SystemStats, error) {
+	if interval <= 0 {
+		return nil, types.ErrInvalidInterval
+	}
+
+	ch := make(chan *types.SystemStats, 1)
+
+	go func() {
+		defer close(ch)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stats, err := p.GetStats(ctx)
+				if err != nil {
+					return
+				}
+				select {
+				case ch <- stats:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// Shutdown cleans up resources used by the provider. The system provider
+// holds no resources, so this is a no-op.
+func (p *Provider) Shutdown() error {
+	return nil
+}
+
+// bootTime reads kern.boottime via sysctl.
+func bootTime() (time.Time, error) {
+	var tv C.struct_timeval
+	if rv := C.get_boot_time(&tv); rv != 0 {
+		return time.Time{}, fmt.Errorf("%w: sysctl kern.boottime failed", ErrBootTimeUnavailable)
+	}
+	return time.Unix(int64(tv.tv_sec), int64(tv.tv_usec)*1000), nil
+}
+
+// hostname returns the machine's hostname, or "" if it cannot be
+// determined.
+func hostname() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
+// kernelVersion reads kern.osrelease via sysctl, returning "" if it cannot
+// be determined.
+func kernelVersion() string {
+	buf := make([]C.char, osreleaseBufSize)
+	if rv := C.get_osrelease(&buf[0], C.size_t(osreleaseBufSize)); rv != 0 {
+		return ""
+	}
+	return C.GoString((*C.char)(unsafe.Pointer(&buf[0])))
+}
@@ -0,0 +1,170 @@
+//go:build freebsd
+// +build freebsd
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// Provider implements system metrics collection (uptime, boot time) for
+// FreeBSD systems. Like internal/cpu/freebsd and internal/power/freebsd, it
+// has no cgo dependency: boot time comes from shelling out to
+// `sysctl -n kern.boottime`, and kernel version from
+// `sysctl -n kern.osrelease`. All methods are thread-safe and can be
+// called from multiple goroutines.
+type Provider struct{}
+
+// var _ asserts that Provider implements metrics.SystemMetrics at compile
+// time, so a signature drift between the two fails the build here instead
+// of surfacing as an opaque "does not implement" error at a call site.
+var _ metrics.SystemMetrics = (*Provider)(nil)
+
+// NewProvider creates a new FreeBSD system metrics provider.
+func NewProvider() *Provider {
+	return &Provider{}
+}
+
+// bootTimeRe matches the `sec = N` field of `sysctl -n kern.boottime`,
+// e.g. "{ sec = 1700000000, usec = 123456 } Tue Jan ...".
+var bootTimeRe = regexp.MustCompile(`sec\s*=\s*(\d+)`)
+
+// bootTime reads kern.boottime via sysctl.
+func bootTime() (time.Time, error) {
+	out, err := sysctlOutput("kern.boottime")
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", ErrBootTimeUnavailable, err)
+	}
+	m := bootTimeRe.FindStringSubmatch(out)
+	if m == nil {
+		return time.Time{}, fmt.Errorf("%w: unexpected kern.boottime output %q", ErrBootTimeUnavailable, out)
+	}
+	sec, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: parse kern.boottime: %v", ErrBootTimeUnavailable, err)
+	}
+	return time.Unix(sec, 0), nil
+}
+
+// sysctlOutput runs `sysctl -n name` and returns its trimmed stdout.
+// internal/cpu/freebsd and internal/power/freebsd each have an identical
+// helper, but none of the three packages share an import path, so this
+// keeps its own copy rather than pulling in a shared internal package for
+// one function.
+func sysctlOutput(name string) (string, error) {
+	out, err := exec.Command("sysctl", "-n", name).Output()
+	if err != nil {
+		return "", fmt.Errorf("sysctl -n %s: %w", name, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// hostname returns the machine's hostname, or "" if it cannot be
+// determined.
+func hostname() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
+// kernelVersion reads kern.osrelease via sysctl, returning "" if it cannot
+// be determined.
+func kernelVersion() string {
+	out, err := sysctlOutput("kern.osrelease")
+	if err != nil {
+		return ""
+	}
+	return out
+}
+
+// BootTime returns the time the system was last booted.
+func (p *Provider) BootTime(ctx context.Context) (time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return time.Time{}, err
+	}
+	return bootTime()
+}
+
+// Uptime returns the duration the system has been running since boot.
+func (p *Provider) Uptime(ctx context.Context) (time.Duration, error) {
+	boot, err := p.BootTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return time.Since(boot), nil
+}
+
+// GetStats returns current system statistics, including both a
+// human-readable and a precise rendering of uptime, plus the hostname and
+// kernel version.
+func (p *Provider) GetStats(ctx context.Context) (*types.SystemStats, error) {
+	boot, err := p.BootTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uptime := time.Since(boot)
+	return &types.SystemStats{
+		BootTime:      boot,
+		Uptime:        uptime,
+		UptimeSeconds: uptime.Seconds(),
+		UptimeString:  formatUptime(uptime),
+		Hostname:      hostname(),
+		KernelVersion: kernelVersion(),
+		Timestamp:     time.Now(),
+	}, nil
+}
+
+// Watch monitors system statistics and sends updates through the returned
+// channel, mirroring the Darwin provider's Watch.
+func (p *Provider) Watch(ctx context.Context, interval time.Duration) (<-chan *types.SystemStats, error) {
+	if interval <= 0 {
+		return nil, types.ErrInvalidInterval
+	}
+
+	ch := make(chan *types.SystemStats, 1)
+
+	go func() {
+		defer close(ch)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stats, err := p.GetStats(ctx)
+				if err != nil {
+					return
+				}
+				select {
+				case ch <- stats:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// Shutdown cleans up resources used by the provider. The system provider
+// holds no resources, so this is a no-op.
+func (p *Provider) Shutdown() error {
+	return nil
+}
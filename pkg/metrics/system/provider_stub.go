@@ -0,0 +1,52 @@
+//go:build !darwin && !freebsd
+// +build !darwin,!freebsd
+
+package system
+
+import (
+	"context"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// Provider implements metrics.SystemMetrics for platforms without a native
+// system metrics backend. Every method returns
+// metrics.ErrUnsupportedPlatform.
+type Provider struct{}
+
+// var _ asserts that Provider implements metrics.SystemMetrics at compile
+// time, so a signature drift between the two fails the build here instead
+// of surfacing as an opaque "does not implement" error at a call site.
+var _ metrics.SystemMetrics = (*Provider)(nil)
+
+// NewProvider creates a new stub system metrics provider.
+func NewProvider() *Provider {
+	return &Provider{}
+}
+
+// BootTime always returns ErrUnsupportedPlatform.
+func (p *Provider) BootTime(context.Context) (time.Time, error) {
+	return time.Time{}, metrics.ErrUnsupportedPlatform
+}
+
+// Uptime always returns ErrUnsupportedPlatform.
+func (p *Provider) Uptime(context.Context) (time.Duration, error) {
+	return 0, metrics.ErrUnsupportedPlatform
+}
+
+// GetStats always returns ErrUnsupportedPlatform.
+func (p *Provider) GetStats(context.Context) (*types.SystemStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// Watch always returns ErrUnsupportedPlatform.
+func (p *Provider) Watch(context.Context, time.Duration) (<-chan *types.SystemStats, error) {
+	return nil, metrics.ErrUnsupportedPlatform
+}
+
+// Shutdown is a no-op; the provider holds no resources.
+func (p *Provider) Shutdown() error {
+	return nil
+}
@@ -0,0 +1,72 @@
+//go:build darwin
+// +build darwin
+
+package system_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/system"
+)
+
+func TestNewProvider(t *testing.T) {
+	provider := system.NewProvider()
+	require.NotNil(t, provider, "provider should not be nil")
+}
+
+func TestProviderBootTime(t *testing.T) {
+	provider := system.NewProvider()
+
+	boot, err := provider.BootTime(context.Background())
+	require.NoError(t, err)
+	assert.True(t, boot.Before(time.Now()), "boot time should be in the past")
+}
+
+func TestProviderUptime(t *testing.T) {
+	provider := system.NewProvider()
+
+	uptime, err := provider.Uptime(context.Background())
+	require.NoError(t, err)
+	assert.Greater(t, uptime, time.Duration(0), "uptime should be > 0")
+}
+
+func TestProviderStats(t *testing.T) {
+	provider := system.NewProvider()
+
+	stats, err := provider.GetStats(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, stats)
+
+	assert.Greater(t, stats.UptimeSeconds, 0.0, "uptime seconds should be > 0")
+	assert.NotEmpty(t, stats.UptimeString, "uptime string should not be empty")
+	assert.NotEmpty(t, stats.Hostname, "hostname should not be empty")
+	assert.NotEmpty(t, stats.KernelVersion, "kernel version should not be empty")
+	assert.WithinDuration(t, time.Now(), stats.Timestamp, 2*time.Second, "timestamp should be recent")
+}
+
+func TestProviderWatch(t *testing.T) {
+	provider := system.NewProvider()
+	defer provider.Shutdown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	ch, err := provider.Watch(ctx, 100*time.Millisecond)
+	require.NoError(t, err)
+
+	var updates int
+	for stats := range ch {
+		assert.Greater(t, stats.UptimeSeconds, 0.0, "uptime seconds should be > 0")
+		updates++
+		if updates >= 3 {
+			break
+		}
+	}
+
+	assert.Greater(t, updates, 0, "should receive at least one update")
+}
@@ -7,16 +7,25 @@ import "time"
 // All percentage values are normalized to range [0.0, 100.0].
 // Time-based fields are calculated over the interval between measurements.
 type CPUStats struct {
-	// User is the percentage of CPU time spent in user space
+	// User is the percentage of CPU time spent in user space, averaged
+	// across cores. Computed from the delta in raw tick counters between
+	// this Provider.GetStats call and the previous one (the same
+	// host_processor_info(PROCESSOR_CPU_LOAD_INFO) sample that backs
+	// TotalUsageUnnormalised), not an instantaneous reading. Reads 0 on
+	// the first call after NewProvider, since there is no previous sample
+	// to diff against yet.
 	User float64
 
-	// System is the percentage of CPU time spent in kernel space
+	// System is the percentage of CPU time spent in kernel space; see User
+	// for how it's computed.
 	System float64
 
-	// Idle is the percentage of CPU time spent idle
+	// Idle is the percentage of CPU time spent idle; see User for how
+	// it's computed.
 	Idle float64
 
-	// Nice is the percentage of CPU time spent on low priority processes
+	// Nice is the percentage of CPU time spent on low priority processes;
+	// see User for how it's computed.
 	Nice float64
 
 	// FrequencyMHz is the current CPU frequency in MHz
@@ -47,18 +56,266 @@ type CPUStats struct {
 	// Values are normalized to [0.0, 100.0]
 	CoreUsage []float64
 
-	// TotalUsage is the total CPU usage percentage across all cores
-	// Normalized to [0.0, 100.0]
+	// TotalUsage is the total CPU usage percentage across all cores,
+	// normalized to [0.0, 100.0]; see User for how it's computed.
 	TotalUsage float64
 
+	// TotalUsageUnnormalised is the total CPU usage percentage across all
+	// cores, summed rather than averaged, ranging [0.0, 100.0*PhysicalCores].
+	// This matches the Elastic Beats distinction between
+	// system.cpu.total.pct (summed) and system.cpu.total.norm.pct
+	// (averaged, i.e. TotalUsage): on an idle 8-core machine with one core
+	// pegged at 100%, TotalUsage reads ~12.5% but TotalUsageUnnormalised
+	// reads 100%, making multi-core saturation visible at a glance.
+	TotalUsageUnnormalised float64
+
+	// UserPct, SystemPct, NicePct, and IdlePct are the per-mode
+	// counterparts to TotalUsageUnnormalised: the same User/System/Nice/Idle
+	// breakdown as the normalized fields above, but summed across cores
+	// instead of averaged, so UserPct+SystemPct+NicePct+IdlePct sums to
+	// TotalUsageUnnormalised+IdlePct, i.e. 100.0*PhysicalCores.
+	UserPct   float64
+	SystemPct float64
+	NicePct   float64
+	IdlePct   float64
+
+	// UsageNanoCores is the decayed-moving-average CPU usage rate, in
+	// units of 1e-9 of a core (so 1_000_000_000 means one core fully
+	// saturated), the same unit the Kubernetes CRI stats API uses for
+	// UsageNanoCores. Unlike TotalUsageUnnormalised, which reports the
+	// instantaneous delta since the previous sample, this is smoothed by
+	// an exponentially-weighted moving average across calls so a single
+	// noisy sample doesn't cause a visible spike; see
+	// internal/cpu/darwin.nanoCoreState. Reads 0 on the first call after
+	// NewProvider, since there is no previous sample to diff against yet.
+	UsageNanoCores uint64
+
 	// LoadAvg contains load averages for 1, 5, and 15 minutes
 	// Each value represents the average system load over the period
 	// where 1.0 means full utilisation of one core
 	LoadAvg [3]float64
 
+	// Interrupts, ContextSwitches, Syscalls, and Traps are counts-per-second
+	// of the respective event, computed from the delta between this sample
+	// and the previous one, the same way CoreUsage and the other
+	// tick-derived fields are. They are key saturation signals (a busy but
+	// not-yet-pegged CPU often shows up first as rising interrupt or
+	// context-switch rates) that this module otherwise drops entirely.
+	//
+	// Darwin does not expose a stable, public Mach or sysctl counter for
+	// these the way Linux's /proc/stat does, so they currently always read
+	// 0 there; see internal/cpu/darwin.GetInterrupts.
+	Interrupts      uint64
+	ContextSwitches uint64
+	Syscalls        uint64
+	Traps           uint64
+
+	// UncoreFrequencyMHz is the uncore/system-agent frequency in MHz, e.g.
+	// Intel's memory controller and last-level cache clock, which runs
+	// independently of the per-core frequencies above. Apple Silicon has no
+	// equivalent single uncore domain; on those chips this is always 0 and
+	// ClusterFrequenciesMHz should be used instead.
+	UncoreFrequencyMHz uint64
+
+	// ClusterFrequenciesMHz reports per-cluster active frequency on Apple
+	// Silicon, where FrequencyMHz/PerfFrequencyMHz/EffiFrequencyMHz collapse
+	// multiple P or E clusters (e.g. the two P-clusters on M1 Max) into a
+	// single number. Empty on Intel processors.
+	ClusterFrequenciesMHz []ClusterFreq
+
+	// Uptime and BootTime report how long the system has been running, so
+	// callers watching CPU stats don't need a second provider just to log
+	// uptime alongside usage. They duplicate pkg/metrics/system.Provider's
+	// Uptime/BootTime, sourced independently via the same sysctl
+	// kern.boottime read.
+	Uptime   time.Duration
+	BootTime time.Time
+
 	// Timestamp records when these stats were collected
 	// Used for calculating deltas between measurements
 	Timestamp time.Time
+
+	// CoreUsageByIndex is a sparse, logical-core-index-keyed view of
+	// CoreUsage, populated only by WatchWithSelector when its CoreSelector
+	// restricts collection to specific indices; nil otherwise (including
+	// on GetStatsFiltered/WatchFiltered, which compact CoreUsage to a
+	// contiguous slice in selection order instead). Watching a handful of
+	// cores on a high-core-count machine with CoreUsageByIndex means a
+	// caller doesn't have to re-derive each sample's original index from
+	// the compacted CoreUsage slice and the CoreSelector it passed in.
+	CoreUsageByIndex map[int]float64
+}
+
+// ClusterFreq reports active frequency for a single CPU cluster on
+// heterogeneous (e.g. Apple Silicon) processors, where cores of the same
+// Type share a clock domain.
+type ClusterFreq struct {
+	// Name identifies the cluster, e.g. "P0" or "E".
+	Name string
+
+	// Type is "P" for a performance cluster or "E" for an efficiency
+	// cluster.
+	Type string
+
+	// ActualMHz is the cluster's current active frequency.
+	ActualMHz uint64
+
+	// MinMHz and MaxMHz are the cluster's supported frequency range.
+	MinMHz uint64
+	MaxMHz uint64
+}
+
+// InterruptStats is the subset of CPUStats returned by a
+// CPUMetrics.GetInterrupts call, for callers who only want IRQ/scheduler
+// saturation figures without paying for a full CPUStats collection.
+type InterruptStats struct {
+	// Interrupts, ContextSwitches, Syscalls, and Traps are counts-per-second
+	// of the respective event; see CPUStats for the caveats that apply to
+	// all four on Darwin.
+	Interrupts      uint64
+	ContextSwitches uint64
+	Syscalls        uint64
+	Traps           uint64
+
+	// Timestamp records when these stats were collected.
+	Timestamp time.Time
+}
+
+// CoreSelector filters which logical cores GetStatsFiltered and
+// WatchFiltered sample and aggregate. All set criteria are ANDed together:
+// Include/Exclude narrow an index allowlist/denylist, and
+// OnlyPerformance/OnlyEfficiency additionally require a matching CoreType.
+// A zero-value CoreSelector selects every core, equivalent to GetStats.
+type CoreSelector struct {
+	// Include, if non-empty, restricts selection to these logical core
+	// indices. An empty Include means "all cores".
+	Include []int
+
+	// Exclude removes these logical core indices from the selection,
+	// applied after Include.
+	Exclude []int
+
+	// OnlyPerformance restricts selection to performance cores on Apple
+	// Silicon. Has no effect on Intel, where every core is
+	// CoreTypeUnknown.
+	OnlyPerformance bool
+
+	// OnlyEfficiency restricts selection to efficiency cores on Apple
+	// Silicon. Has no effect on Intel.
+	OnlyEfficiency bool
+}
+
+// WatchOptions configures CPUMetrics.WatchWithSelector, bundling the poll
+// interval with a CoreSelector so a caller restricting collection to a
+// subset of cores (e.g. only P-cores on a high-core-count Mac) doesn't need
+// to pass two separate parameters the way WatchFiltered does.
+type WatchOptions struct {
+	// Interval is the poll interval between samples; see Watch.
+	Interval time.Duration
+
+	// Selector restricts which cores are reported, the same way it does
+	// for GetStatsFiltered/WatchFiltered. A zero-value Selector selects
+	// every core.
+	Selector CoreSelector
+}
+
+// CollectOptions configures which parts of a CPUStats sample
+// GetStatsWithOptions actually populates, mirroring the percpu/totalcpu
+// toggles from the telegraf system plugin. This lets callers that only
+// need, say, load averages skip scanning and returning per-core and
+// per-mode tick breakdowns they don't need, and lets dashboards vs.
+// exporters trade fidelity for cost.
+type CollectOptions struct {
+	// PerCPU, if true, populates CoreUsage with one entry per logical
+	// core. If false, CoreUsage is left nil.
+	PerCPU bool
+
+	// TotalCPU, if true, populates TotalUsage (and, if Normalised is
+	// false, the unnormalised counterpart). If false, both are left zero.
+	TotalCPU bool
+
+	// PerState, if true, populates the User/System/Idle/Nice breakdown
+	// (and their *Pct counterparts). If false, only TotalUsage is
+	// populated (subject to TotalCPU), matching telegraf's "just give me
+	// the summed total" mode.
+	PerState bool
+
+	// Normalised selects which variant TotalCPU/PerState populate:
+	// normalised (0..100, averaged across cores, the CPUStats default) if
+	// true, or the raw Beats-style unnormalised (0..100*PhysicalCores,
+	// summed across cores) fields if false.
+	Normalised bool
+}
+
+// DefaultCollectOptions collects everything GetStats normally does:
+// PerCPU, TotalCPU, and PerState all enabled, Normalised percentages.
+func DefaultCollectOptions() CollectOptions {
+	return CollectOptions{PerCPU: true, TotalCPU: true, PerState: true, Normalised: true}
+}
+
+// CoreType classifies a logical core on heterogeneous (e.g. Apple Silicon)
+// processors.
+type CoreType string
+
+const (
+	// CoreTypeUnknown indicates the core type could not be determined,
+	// which is always the case on non-heterogeneous (e.g. Intel) processors.
+	CoreTypeUnknown CoreType = "Unknown"
+	// CoreTypePerformance indicates a performance ("P") core.
+	CoreTypePerformance CoreType = "Performance"
+	// CoreTypeEfficiency indicates an efficiency ("E") core.
+	CoreTypeEfficiency CoreType = "Efficiency"
+)
+
+// CoreStats represents per-core CPU statistics computed from the delta
+// between two successive tick samples.
+type CoreStats struct {
+	// CoreID is the logical core index.
+	CoreID int
+
+	// CoreType classifies the core as Performance/Efficiency on Apple
+	// Silicon, or CoreTypeUnknown when the distinction doesn't apply.
+	CoreType CoreType
+
+	// User, System, Idle, and Nice are percentages of time this core spent
+	// in each mode since the previous sample, normalized to [0.0, 100.0].
+	User   float64
+	System float64
+	Idle   float64
+	Nice   float64
+
+	// Timestamp records when this sample was collected.
+	Timestamp time.Time
+}
+
+// CoreResidency reports per-core frequency and residency detail on Apple
+// Silicon, the finer-grained counterpart to CPUStats' single
+// PerfFrequencyMHz/EffiFrequencyMHz bucket. Returned by
+// CPUMetrics.GetCoreResidencies; see CoreResidency's source,
+// internal/cpu/darwin.getCoreResidencies, for why this is sampled via
+// powermetrics rather than a direct IOReport binding.
+type CoreResidency struct {
+	// CoreIndex is the logical core index.
+	CoreIndex int
+
+	// CoreType classifies the core as Performance/Efficiency, mirroring
+	// CoreStats.CoreType. Always CoreTypeUnknown on non-Apple-Silicon
+	// platforms.
+	CoreType CoreType
+
+	// FrequencyMHz is this core's instantaneous active frequency.
+	FrequencyMHz uint64
+
+	// ActiveResidencyPct and IdleResidencyPct are the percentage of the
+	// sample window this core spent active vs. idle, summing to
+	// approximately 100.0.
+	ActiveResidencyPct float64
+	IdleResidencyPct   float64
+
+	// DVFMStateResidencyPct breaks ActiveResidencyPct down further by
+	// dynamic voltage/frequency-management (DVFM) state, e.g.
+	// {"P0": 40.0, "P1": 60.0}. Keys and cardinality vary by chip.
+	DVFMStateResidencyPct map[string]float64
 }
 
 // CPUPlatform represents CPU platform information.
@@ -91,4 +348,14 @@ type CPUPlatform struct {
 
 	// EfficiencyCores is the number of efficiency cores
 	EfficiencyCores int
+
+	// UncoreFrequencyMHz is the uncore/system-agent frequency in MHz on
+	// Intel processors; see CPUStats.UncoreFrequencyMHz. Always 0 on Apple
+	// Silicon.
+	UncoreFrequencyMHz uint64
+
+	// ClusterFrequenciesMHz reports per-cluster frequency ranges on Apple
+	// Silicon; see CPUStats.ClusterFrequenciesMHz. Empty on Intel
+	// processors.
+	ClusterFrequenciesMHz []ClusterFreq
 }
@@ -0,0 +1,32 @@
+// Package types provides type definitions for system metrics collection.
+package types
+
+import "time"
+
+// SystemStats represents system-level statistics such as uptime and boot
+// time.
+type SystemStats struct {
+	// BootTime is the time the system was last booted.
+	BootTime time.Time
+
+	// Uptime is the duration the system has been running since BootTime.
+	Uptime time.Duration
+
+	// UptimeSeconds is Uptime expressed as float64 seconds, for metrics
+	// exporters that prefer a plain numeric value.
+	UptimeSeconds float64
+
+	// UptimeString is a human-readable rendering of Uptime, e.g.
+	// "3 days, 4 hours".
+	UptimeString string
+
+	// Hostname is the machine's hostname, as reported by the OS.
+	Hostname string
+
+	// KernelVersion is the OS kernel release string, e.g. "23.6.0" on a
+	// Darwin system reading sysctl kern.osrelease.
+	KernelVersion string
+
+	// Timestamp records when these stats were collected.
+	Timestamp time.Time
+}
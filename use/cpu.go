@@ -0,0 +1,99 @@
+package use
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// cpuComponent implements Component for a CPU provider.
+//
+// Utilization is CPUStats.TotalUsage directly, since TotalUsage is already
+// "100 - idle%". Saturation is the run-queue length implied by the 1-minute
+// load average divided by the physical core count: a value above 1.0 means
+// more runnable work exists than the machine has cores to service it
+// immediately. Errors counts failed GetContainerStats calls, since this
+// module does not currently expose the machine-check/IOKit power-event
+// error counters a production USE dashboard would ultimately want.
+type cpuComponent struct {
+	cpu metrics.CPUMetrics
+
+	mu   sync.Mutex
+	errs uint64
+}
+
+// NewCPUComponent creates a Component that derives USE Method metrics from
+// provider.
+func NewCPUComponent(provider metrics.CPUMetrics) Component {
+	return &cpuComponent{cpu: provider}
+}
+
+func (c *cpuComponent) stats(ctx context.Context) (*types.CPUStats, error) {
+	stats, err := c.cpu.GetContainerStats(ctx, nil)
+	if err != nil {
+		c.mu.Lock()
+		c.errs++
+		c.mu.Unlock()
+		return nil, err
+	}
+	return stats, nil
+}
+
+// CollectUtilization returns CPUStats.TotalUsage, normalised to [0, 100].
+func (c *cpuComponent) CollectUtilization(ctx context.Context) (float64, error) {
+	stats, err := c.stats(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return stats.TotalUsage, nil
+}
+
+// CollectSaturation returns LoadAvg[0]/PhysicalCores, the average number of
+// runnable processes per core over the last minute. Values above 1.0
+// indicate the CPU is saturated.
+func (c *cpuComponent) CollectSaturation(ctx context.Context) (float64, error) {
+	stats, err := c.stats(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if stats.PhysicalCores == 0 {
+		return 0, nil
+	}
+	return stats.LoadAvg[0] / float64(stats.PhysicalCores), nil
+}
+
+// CollectErrors returns the number of failed stats collections observed so
+// far.
+func (c *cpuComponent) CollectErrors(_ context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.errs, nil
+}
+
+// Metrics collects a single CPUStats sample and derives Utilization and
+// Saturation from it, so both describe the same point in time.
+func (c *cpuComponent) Metrics(ctx context.Context) (USEMetrics, error) {
+	stats, err := c.stats(ctx)
+	if err != nil {
+		return USEMetrics{}, err
+	}
+
+	saturation := 0.0
+	if stats.PhysicalCores > 0 {
+		saturation = stats.LoadAvg[0] / float64(stats.PhysicalCores)
+	}
+
+	c.mu.Lock()
+	errs := c.errs
+	c.mu.Unlock()
+
+	return USEMetrics{
+		Utilization: stats.TotalUsage,
+		Saturation:  saturation,
+		Errors:      errs,
+		Timestamp:   time.Now(),
+	}, nil
+}
@@ -0,0 +1,30 @@
+// Package use computes Brendan Gregg's USE Method (Utilization, Saturation,
+// Errors) metrics by composing the module's existing CPU and power
+// providers, giving callers a single, comparable health view across
+// resources instead of having to interpret each provider's raw fields
+// themselves.
+//
+// A Component implements the three USE questions for one resource.
+// Registry groups a fixed set of Components, mirroring how pkg/exporter
+// groups Collectors and pkg/metrics/registry groups metric readers.
+//
+// Memory is not yet covered: this module has no memory provider to compose
+// (see the root package doc's "Planned" list), so there is no
+// CollectUtilization/CollectSaturation/CollectErrors implementation for it
+// here yet.
+//
+// Example usage:
+//
+//	cpuProvider := cpu.NewProvider()
+//	powerProvider := power.NewProvider()
+//	defer cpuProvider.Shutdown()
+//	defer powerProvider.Shutdown()
+//
+//	reg := use.New(cpuProvider, powerProvider)
+//
+//	snap, err := reg.Collect(context.Background())
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("CPU: util=%.1f%% sat=%.2f\n", snap.CPU.Utilization, snap.CPU.Saturation)
+package use
@@ -0,0 +1,94 @@
+package use
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+	"github.com/sm-moshi/dmetrics-go/pkg/metrics/types"
+)
+
+// powerComponent implements Component for a power provider.
+//
+// Utilization is how drained the battery is (100 - Percentage) while
+// running on battery power, and 0 while on AC, since the battery isn't the
+// resource being consumed in that case. Saturation has no equivalent
+// signal in PowerStats today and always reads 0. Errors is intended to
+// count thermal throttling events, but PowerStats does not yet expose a
+// throttling counter, so it always reads 0; a future power provider change
+// would need to add one for this to be meaningful.
+type powerComponent struct {
+	power metrics.PowerMetrics
+
+	mu   sync.Mutex
+	errs uint64
+}
+
+// NewPowerComponent creates a Component that derives USE Method metrics
+// from provider.
+func NewPowerComponent(provider metrics.PowerMetrics) Component {
+	return &powerComponent{power: provider}
+}
+
+func (p *powerComponent) stats(ctx context.Context) (*types.PowerStats, error) {
+	stats, err := p.power.GetStats(ctx)
+	if err != nil {
+		p.mu.Lock()
+		p.errs++
+		p.mu.Unlock()
+		return nil, err
+	}
+	return stats, nil
+}
+
+// CollectUtilization returns 100-Percentage while on battery power, or 0
+// while on AC power.
+func (p *powerComponent) CollectUtilization(ctx context.Context) (float64, error) {
+	stats, err := p.stats(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return utilizationFromPowerStats(stats), nil
+}
+
+// CollectSaturation always returns 0: no queuing signal for power is
+// available from PowerStats yet.
+func (p *powerComponent) CollectSaturation(_ context.Context) (float64, error) {
+	return 0, nil
+}
+
+// CollectErrors returns the number of failed stats collections observed so
+// far. It does not yet count thermal throttling events; see powerComponent.
+func (p *powerComponent) CollectErrors(_ context.Context) (uint64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.errs, nil
+}
+
+// Metrics collects a single PowerStats sample and derives Utilization from
+// it, so it describes the same point in time as Errors.
+func (p *powerComponent) Metrics(ctx context.Context) (USEMetrics, error) {
+	stats, err := p.stats(ctx)
+	if err != nil {
+		return USEMetrics{}, err
+	}
+
+	p.mu.Lock()
+	errs := p.errs
+	p.mu.Unlock()
+
+	return USEMetrics{
+		Utilization: utilizationFromPowerStats(stats),
+		Saturation:  0,
+		Errors:      errs,
+		Timestamp:   time.Now(),
+	}, nil
+}
+
+func utilizationFromPowerStats(stats *types.PowerStats) float64 {
+	if stats.Source != types.PowerSourceBattery {
+		return 0
+	}
+	return 100 - stats.Percentage
+}
@@ -0,0 +1,92 @@
+package use
+
+import (
+	"context"
+	"time"
+
+	"github.com/sm-moshi/dmetrics-go/api/metrics"
+)
+
+// Snapshot is the set of USEMetrics collected from every Component in a
+// Registry in one call to Collect.
+type Snapshot struct {
+	CPU   USEMetrics
+	Power USEMetrics
+
+	// Timestamp records when the snapshot as a whole was collected.
+	Timestamp time.Time
+}
+
+// Registry groups the Components that make up a single USE Method view
+// across this module's providers. A Registry is safe for concurrent use if
+// the providers it wraps are.
+type Registry struct {
+	cpu   Component
+	power Component
+}
+
+// New creates a Registry deriving USE Method metrics from cpu and power.
+func New(cpu metrics.CPUMetrics, power metrics.PowerMetrics) *Registry {
+	return &Registry{
+		cpu:   NewCPUComponent(cpu),
+		power: NewPowerComponent(power),
+	}
+}
+
+// Collect gathers a Snapshot from every Component. If a Component's
+// Metrics call fails, its USEMetrics is left zero-valued rather than
+// failing the whole Snapshot, so one struggling resource doesn't hide the
+// others.
+func (r *Registry) Collect(ctx context.Context) (Snapshot, error) {
+	snap := Snapshot{Timestamp: time.Now()}
+
+	if m, err := r.cpu.Metrics(ctx); err == nil {
+		snap.CPU = m
+	}
+	if m, err := r.power.Metrics(ctx); err == nil {
+		snap.Power = m
+	}
+
+	return snap, nil
+}
+
+// Watch starts monitoring USE Method metrics and sends a Snapshot to the
+// returned channel every interval. The context can be used to stop
+// monitoring. When the context is cancelled, the channel is closed after
+// any pending send completes.
+//
+// The returned channel is buffered with a capacity of 1, mirroring the
+// rest of the module's Watch methods.
+func (r *Registry) Watch(ctx context.Context, interval time.Duration) (<-chan Snapshot, error) {
+	if interval <= 0 {
+		return nil, metrics.ErrInvalidInterval
+	}
+
+	ch := make(chan Snapshot, 1)
+
+	go func() {
+		defer close(ch)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				snap, err := r.Collect(ctx)
+				if err != nil {
+					return
+				}
+				select {
+				case ch <- snap:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
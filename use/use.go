@@ -0,0 +1,47 @@
+package use
+
+import (
+	"context"
+	"time"
+)
+
+// USEMetrics is one resource's reading under Brendan Gregg's USE Method.
+type USEMetrics struct {
+	// Utilization is the percentage of time the resource was busy servicing
+	// work, normalised to [0.0, 100.0].
+	Utilization float64
+
+	// Saturation is the degree to which work is queued waiting for the
+	// resource beyond what it can service immediately. Unlike Utilization,
+	// it is not normalised to a fixed range: a value above 1.0 generally
+	// indicates the resource is a bottleneck.
+	Saturation float64
+
+	// Errors is the cumulative count of error events observed for the
+	// resource since the Component was created.
+	Errors uint64
+
+	// Timestamp records when these metrics were collected.
+	Timestamp time.Time
+}
+
+// Component computes USE Method metrics for a single resource by composing
+// one of the module's existing providers. Implementations must be safe for
+// concurrent use.
+type Component interface {
+	// CollectUtilization returns the resource's current utilisation
+	// percentage, normalised to [0.0, 100.0].
+	CollectUtilization(ctx context.Context) (float64, error)
+
+	// CollectSaturation returns the resource's current saturation reading.
+	CollectSaturation(ctx context.Context) (float64, error)
+
+	// CollectErrors returns the cumulative error count observed for the
+	// resource since the Component was created.
+	CollectErrors(ctx context.Context) (uint64, error)
+
+	// Metrics returns a single USEMetrics snapshot combining Utilization,
+	// Saturation, and Errors, collected together so the three values
+	// describe the same point in time.
+	Metrics(ctx context.Context) (USEMetrics, error)
+}